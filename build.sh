@@ -76,7 +76,7 @@ build_single() {
             -X 'main.gitHash=${GIT_HASH}' \
             -X 'main.buildTime=${BUILD_TIME}'" \
         -o "$OUTPUT" \
-        ./src/main.go
+        ./src
 
     if [ $? -eq 0 ]; then
         echo -e "${GREEN}✓ 构建成功: $OUTPUT${NC}"
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// --adaptive 使用的超时和间隔范围
+const (
+	adaptiveMinTimeout  = 100 * time.Millisecond
+	adaptiveMaxTimeout  = 10 * time.Second
+	adaptiveMinInterval = 100 * time.Millisecond
+)
+
+// adaptiveTimer 按观察到的往返时间计算超时，算法与 TCP 的重传超时相同（RFC 6298）：
+// 超时为 srtt + 4×rttvar，超时失败后加倍。探测失败期间以及恢复后的第一次探测把间隔缩短到四分之一，
+// 以便确定中断开始和结束的时间，之后连续成功时每次把间隔加倍，直到回到 -t 指定的间隔
+type adaptiveTimer struct {
+	srtt, rttvar time.Duration
+	sampled      bool
+	rto          time.Duration
+	base         time.Duration // -t 指定的间隔
+	interval     time.Duration
+	lastSuccess  bool // 上一次探测是否成功，开始时视为成功
+}
+
+func newAdaptiveTimer(opts *Options) *adaptiveTimer {
+	base := time.Duration(opts.Interval) * time.Millisecond
+	return &adaptiveTimer{
+		rto:         clampDuration(time.Duration(opts.Timeout)*time.Millisecond, adaptiveMinTimeout, adaptiveMaxTimeout),
+		base:        base,
+		interval:    base,
+		lastSuccess: true,
+	}
+}
+
+// fastInterval 返回中断期间和刚恢复时使用的间隔
+func (a *adaptiveTimer) fastInterval() time.Duration {
+	return min(a.base, max(a.base/4, adaptiveMinInterval))
+}
+
+func clampDuration(d, lo, hi time.Duration) time.Duration {
+	return max(lo, min(d, hi))
+}
+
+// observe 根据一次探测的结果更新超时和间隔
+func (a *adaptiveTimer) observe(r probeResult) {
+	if !r.success || !a.lastSuccess {
+		a.interval = a.fastInterval()
+	} else {
+		a.interval = min(2*a.interval, a.base)
+	}
+	a.lastSuccess = r.success
+
+	if r.success {
+		rtt := time.Duration(r.rtt * float64(time.Millisecond))
+		if !a.sampled {
+			a.srtt, a.rttvar, a.sampled = rtt, rtt/2, true
+		} else {
+			diff := a.srtt - rtt
+			if diff < 0 {
+				diff = -diff
+			}
+			a.rttvar = (3*a.rttvar + diff) / 4
+			a.srtt = (7*a.srtt + rtt) / 8
+		}
+		a.rto = clampDuration(a.srtt+4*a.rttvar, adaptiveMinTimeout, adaptiveMaxTimeout)
+		return
+	}
+	// 只有超时说明超时过短，连接被拒绝等错误不影响超时
+	if errorClass(r.err) == "timeout" {
+		a.rto = min(2*a.rto, adaptiveMaxTimeout)
+	}
+}
+
+// probeTimeoutKey 是单次探测超时在上下文中的键
+type probeTimeoutKey struct{}
+
+// withProbeTimeout 把 --adaptive 为本次探测计算的超时附加到上下文
+func withProbeTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, probeTimeoutKey{}, timeout)
+}
+
+// probeTimeout 返回本次探测使用的超时：--adaptive 计算的值，否则为 -w
+func probeTimeout(ctx context.Context, opts *Options) time.Duration {
+	if timeout, ok := ctx.Value(probeTimeoutKey{}).(time.Duration); ok {
+		return timeout
+	}
+	return time.Duration(opts.Timeout) * time.Millisecond
+}
+
+// adaptiveProbe 是 --adaptive 为一次探测选择的超时和距上一次探测的间隔
+type adaptiveProbe struct {
+	seq      int
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// updateAdaptive 记录一次探测使用的超时和间隔
+func (s *Statistics) updateAdaptive(seq int, timeout, interval time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+	s.adaptiveTimeout.add(durationMillis(timeout))
+	s.adaptiveInterval.add(durationMillis(interval))
+	s.adaptiveProbes = append(s.adaptiveProbes, adaptiveProbe{seq: seq, timeout: timeout, interval: interval})
+}
+
+// getAdaptiveProbes 返回 --adaptive 每次探测使用的超时和间隔
+func (s *Statistics) getAdaptiveProbes() []adaptiveProbe {
+	s.RLock()
+	defer s.RUnlock()
+	return append([]adaptiveProbe(nil), s.adaptiveProbes...)
+}
+
+// getAdaptiveStats 返回 --adaptive 使用的超时和间隔的范围
+func (s *Statistics) getAdaptiveStats() (timeout, interval latencyGroup) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.adaptiveTimeout, s.adaptiveInterval
+}
+
+// printAdaptiveStatistics 输出 --adaptive 使用的超时和间隔的范围
+func printAdaptiveStatistics(stats *Statistics) {
+	lang := i18n.T()
+	timeout, interval := stats.getAdaptiveStats()
+	if timeout.count == 0 {
+		return
+	}
+	fmt.Printf(lang.MsgStatisticsAdaptiveTimeout(), timeout.min, timeout.max, timeout.avg())
+	fmt.Printf(lang.MsgStatisticsAdaptiveInterval(), interval.min, interval.max, interval.avg())
+}
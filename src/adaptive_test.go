@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveTimerTimeout(t *testing.T) {
+	a := newAdaptiveTimer(&Options{Interval: 1000, Timeout: 1000})
+	if a.rto != time.Second {
+		t.Fatalf("initial timeout = %v, want -w", a.rto)
+	}
+	a.observe(probeResult{success: true, rtt: 200})
+	// 第一个样本：srtt = 200ms，rttvar = 100ms
+	if a.rto != 600*time.Millisecond {
+		t.Errorf("timeout after first sample = %v, want 600ms", a.rto)
+	}
+	for i := 0; i < 50; i++ {
+		a.observe(probeResult{success: true, rtt: 200})
+	}
+	if a.rto < 200*time.Millisecond || a.rto > 220*time.Millisecond {
+		t.Errorf("timeout after stable samples = %v, want close to srtt", a.rto)
+	}
+	for i := 0; i < 50; i++ {
+		a.observe(probeResult{success: true, rtt: 0.3})
+	}
+	if a.rto != adaptiveMinTimeout {
+		t.Errorf("timeout on a fast link = %v, want %v", a.rto, adaptiveMinTimeout)
+	}
+
+	a.observe(probeResult{err: "dial tcp 127.0.0.1:1: connect: connection refused"})
+	if a.rto != adaptiveMinTimeout {
+		t.Errorf("refused connection changed the timeout to %v", a.rto)
+	}
+	for i := 0; i < 10; i++ {
+		a.observe(probeResult{err: "dial tcp 10.0.0.1:80: i/o timeout"})
+	}
+	if a.rto != adaptiveMaxTimeout {
+		t.Errorf("timeout after repeated timeouts = %v, want %v", a.rto, adaptiveMaxTimeout)
+	}
+}
+
+func TestAdaptiveTimerInterval(t *testing.T) {
+	a := newAdaptiveTimer(&Options{Interval: 1000, Timeout: 1000})
+	steps := []struct {
+		success bool
+		want    time.Duration
+	}{
+		{true, time.Second},
+		{false, 250 * time.Millisecond}, // 开始中断
+		{false, 250 * time.Millisecond}, // 中断期间保持快速探测
+		{false, 250 * time.Millisecond},
+		{false, 250 * time.Millisecond},
+		{true, 250 * time.Millisecond}, // 恢复
+		{true, 500 * time.Millisecond},
+		{true, time.Second},
+		{true, time.Second},
+		{false, 250 * time.Millisecond}, // 再次中断
+		{true, 250 * time.Millisecond},
+		{false, 250 * time.Millisecond}, // 恢复后立即又失败
+		{true, 250 * time.Millisecond},
+		{true, 500 * time.Millisecond},
+	}
+	for i, s := range steps {
+		a.observe(probeResult{success: s.success, rtt: 1, err: "connection refused"})
+		if a.interval != s.want {
+			t.Errorf("step %d: interval = %v, want %v", i, a.interval, s.want)
+		}
+	}
+
+	if got := newAdaptiveTimer(&Options{Interval: 200}).fastInterval(); got != adaptiveMinInterval {
+		t.Errorf("fast interval = %v, want %v", got, adaptiveMinInterval)
+	}
+	if got := newAdaptiveTimer(&Options{Interval: 50}).fastInterval(); got != 50*time.Millisecond {
+		t.Errorf("fast interval = %v, should not exceed -t", got)
+	}
+}
+
+func TestProbeScheduleRetime(t *testing.T) {
+	start := time.Now()
+	s := newProbeSchedule(&Options{Interval: 1000}, start)
+	s.retime(1, 250*time.Millisecond, start)
+	if got := s.due(1).Sub(start); got != 250*time.Millisecond {
+		t.Errorf("due(1) = +%v, want 250ms", got)
+	}
+	if got := s.due(2).Sub(start); got != 500*time.Millisecond {
+		t.Errorf("due(2) = +%v, want 500ms", got)
+	}
+	// 上一次探测耗时超过新的间隔时立即发出
+	s.retime(3, 100*time.Millisecond, start.Add(2*time.Second))
+	if got := s.due(3).Sub(start); got != 2*time.Second {
+		t.Errorf("due(3) = +%v, want 2s", got)
+	}
+}
+
+func TestRunProbeLoopAdaptive(t *testing.T) {
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	opts := &Options{Count: 5, Interval: 400, Timeout: 1000, Adaptive: true}
+	stats := &Statistics{}
+	var results []probeResult
+	var mu sync.Mutex
+	probeTimeouts := make(map[int]time.Duration)
+	probe := func(ctx context.Context, seq int) probeResult {
+		mu.Lock()
+		probeTimeouts[seq] = probeTimeout(ctx, opts)
+		mu.Unlock()
+		// seq 1 失败，其余成功
+		return probeResult{seq: seq, time: time.Now(), rtt: 20, success: seq != 1, err: "i/o timeout"}
+	}
+	start := time.Now()
+	runProbeLoop(context.Background(), opts, stats, probe, func(r probeResult) { results = append(results, r) })
+	elapsed := time.Since(start)
+
+	if len(results) != 5 {
+		t.Fatalf("results = %d, want 5", len(results))
+	}
+	if results[0].timeout != time.Second || results[1].timeout != 100*time.Millisecond {
+		t.Errorf("timeouts = %v, %v, want 1s then derived from the 20ms sample", results[0].timeout, results[1].timeout)
+	}
+	if results[2].timeout != 200*time.Millisecond {
+		t.Errorf("timeout after a timeout = %v, want doubled", results[2].timeout)
+	}
+	// 间隔依次为 400、100（失败）、100（恢复）、200ms
+	wantIntervals := []time.Duration{400, 400, 100, 100, 200}
+	for i, r := range results {
+		if r.interval != wantIntervals[i]*time.Millisecond {
+			t.Errorf("seq %d interval = %v, want %vms", i, r.interval, wantIntervals[i])
+		}
+	}
+	if elapsed < 780*time.Millisecond || elapsed > 1100*time.Millisecond {
+		t.Errorf("elapsed = %v, want about 800ms", elapsed)
+	}
+	if timeout, interval := stats.getAdaptiveStats(); timeout.count != 5 || interval.min != 100 {
+		t.Errorf("adaptive stats = %+v / %+v", timeout, interval)
+	}
+	if late, skipped := stats.getScheduleStats(); late != 0 || skipped != 0 {
+		t.Errorf("late/skipped = %d/%d, adaptive probes should not be reported late", late, skipped)
+	}
+	if opts.Timeout != 1000 {
+		t.Errorf("opts.Timeout = %d, -w must not be overwritten", opts.Timeout)
+	}
+	// 探测通过上下文拿到的超时与记录的一致
+	for _, r := range results {
+		if probeTimeouts[r.seq] != r.timeout {
+			t.Errorf("seq %d probe saw timeout %v, recorded %v", r.seq, probeTimeouts[r.seq], r.timeout)
+		}
+	}
+
+	summary := buildJSONSummary("TCP", "example.com:80", stats, stopNone, verdict{})
+	if summary.Adaptive == nil || len(summary.Adaptive.Probes) != 5 {
+		t.Fatalf("adaptive summary = %+v, want 5 probes", summary.Adaptive)
+	}
+	for i, p := range summary.Adaptive.Probes {
+		if p.Seq != i || p.Timeout != durationMillis(results[i].timeout) || p.Interval != float64(wantIntervals[i]) {
+			t.Errorf("probes[%d] = %+v, want timeout %v interval %vms", i, p, results[i].timeout, wantIntervals[i])
+		}
+	}
+}
+
+func TestRunProbeLoopAdaptiveOutage(t *testing.T) {
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	opts := &Options{Count: 9, Interval: 400, Timeout: 1000, Adaptive: true}
+	var results []probeResult
+	probe := func(ctx context.Context, seq int) probeResult {
+		// seq 2-5 连续失败
+		success := seq < 2 || seq > 5
+		return probeResult{seq: seq, time: time.Now(), rtt: 20, success: success, err: "connection refused"}
+	}
+	runProbeLoop(context.Background(), opts, &Statistics{}, probe, func(r probeResult) { results = append(results, r) })
+
+	if len(results) != 9 {
+		t.Fatalf("results = %d, want 9", len(results))
+	}
+	// 第一次失败之后直到恢复后的第一次探测都使用 100ms，之后逐次加倍回到 -t
+	wantIntervals := []time.Duration{400, 400, 400, 100, 100, 100, 100, 100, 200}
+	for i, r := range results {
+		if r.interval != wantIntervals[i]*time.Millisecond {
+			t.Errorf("seq %d (success=%v) interval = %v, want %vms", r.seq, r.success, r.interval, wantIntervals[i])
+		}
+	}
+}
+
+func TestProbeTimeoutDefault(t *testing.T) {
+	opts := &Options{Timeout: 1500}
+	if got := probeTimeout(context.Background(), opts); got != 1500*time.Millisecond {
+		t.Errorf("probeTimeout without --adaptive = %v, want -w", got)
+	}
+	ctx := withProbeTimeout(context.Background(), 250*time.Millisecond)
+	if got := probeTimeout(ctx, opts); got != 250*time.Millisecond {
+		t.Errorf("probeTimeout with adaptive value = %v, want 250ms", got)
+	}
+}
+
+func TestValidateAdaptiveOptions(t *testing.T) {
+	for _, opts := range []*Options{
+		{Adaptive: true, MaxInFlight: 2},
+		{Adaptive: true, Rate: 10},
+		{Adaptive: true, FindIdleTimeout: true, IdleMin: time.Second, IdleMax: time.Minute},
+	} {
+		if err := validateModes(opts); err == nil || !strings.Contains(err.Error(), "--adaptive") {
+			t.Errorf("%+v: %v", opts, err)
+		}
+	}
+	if maxInFlight(&Options{Adaptive: true, Interval: 100, Timeout: 1000}) != 1 {
+		t.Error("--adaptive should probe one at a time")
+	}
+}
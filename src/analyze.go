@@ -0,0 +1,347 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// analyzeOptions 是 tcping analyze 子命令的选项
+type analyzeOptions struct {
+	From     time.Time // 只分析此时间及之后的记录
+	To       time.Time // 只分析此时间之前的记录
+	Merge    bool      // 合并不同文件中同一目标的记录
+	JSON     bool
+	Language string
+	ShowHelp bool
+}
+
+func analyzeOptionSpecs(o *analyzeOptions) []optionSpec {
+	return []optionSpec{
+		{0, "from", funcValue(func(s string) (err error) {
+			o.From, err = parseRecordTime(s)
+			return err
+		})},
+		{0, "to", funcValue(func(s string) (err error) {
+			o.To, err = parseRecordTime(s)
+			return err
+		})},
+		{0, "merge", (*boolValue)(&o.Merge)},
+		{0, "json", (*boolValue)(&o.JSON)},
+		{'l', "language", (*stringValue)(&o.Language)},
+		{'h', "help", (*boolValue)(&o.ShowHelp)},
+	}
+}
+
+// recordTimeLayouts 是 --from/--to 接受的本地时间格式
+var recordTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseRecordTime 解析 RFC 3339 时间，或按本地时区解析不带时区的时间
+func parseRecordTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range recordTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf(i18n.T().ErrorRecordTime(), s)
+}
+
+// readRecords 读取 --record 写入的文件，忽略空行
+func readRecords(path string) ([]recordEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T().ErrorRecordOpen(), path, err)
+	}
+	defer f.Close()
+
+	var records []recordEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var e recordEntry
+		if err := json.Unmarshal(text, &e); err != nil {
+			return nil, fmt.Errorf(i18n.T().ErrorRecordParse(), path, line, err)
+		}
+		records = append(records, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf(i18n.T().ErrorRecordOpen(), path, err)
+	}
+	return records, nil
+}
+
+// recordGroup 是一起分析的一组记录：默认为同一文件中的同一目标，
+// --merge 时为所有文件中的同一目标
+type recordGroup struct {
+	target  string
+	mode    string
+	files   []string
+	records []recordEntry
+}
+
+// label 返回输出中区分各组的名称
+func (g *recordGroup) label(merged bool) string {
+	name := fmt.Sprintf("%s (%s)", g.target, g.mode)
+	if !merged {
+		name += " @ " + filepath.Base(g.files[0])
+	}
+	return name
+}
+
+// groupRecords 按目标分组并过滤时间范围，各组按首次出现的顺序排列，组内按时间排序
+func groupRecords(files []string, records [][]recordEntry, opts *analyzeOptions) []*recordGroup {
+	var groups []*recordGroup
+	index := make(map[string]*recordGroup)
+	for i, file := range files {
+		for _, e := range records[i] {
+			if (!opts.From.IsZero() && e.Time.Before(opts.From)) || (!opts.To.IsZero() && !e.Time.Before(opts.To)) {
+				continue
+			}
+			key := e.Target + "\x00" + e.Mode
+			if !opts.Merge {
+				key += "\x00" + file
+			}
+			g := index[key]
+			if g == nil {
+				g = &recordGroup{target: e.Target, mode: e.Mode}
+				index[key] = g
+				groups = append(groups, g)
+			}
+			if len(g.files) == 0 || g.files[len(g.files)-1] != file {
+				g.files = append(g.files, file)
+			}
+			g.records = append(g.records, e)
+		}
+	}
+	for _, g := range groups {
+		sort.SliceStable(g.records, func(a, b int) bool { return g.records[a].Time.Before(g.records[b].Time) })
+	}
+	return groups
+}
+
+// analysis 是从一组记录重新计算出的统计
+type analysis struct {
+	group       *recordGroup
+	stats       *Statistics
+	percentiles []float64 // P50、P90、P99、P99.9
+	first       time.Time
+	last        time.Time
+	outages     []outage
+}
+
+// analyzeGroup 重新计算统计和中断区间。中断按运行分别计算，
+// 一次运行结束时仍未恢复的中断保持 ongoing
+func analyzeGroup(g *recordGroup) *analysis {
+	a := &analysis{group: g, stats: &Statistics{}}
+	runs := make(map[string][]outage)
+	var order []string
+	for _, e := range g.records {
+		a.stats.update(e.RTT, e.Success)
+		if _, ok := runs[e.Run]; !ok {
+			order = append(order, e.Run)
+		}
+		runs[e.Run] = updateOutages(runs[e.Run], probeResult{time: e.Time, success: e.Success})
+	}
+	for _, run := range order {
+		a.outages = append(a.outages, runs[run]...)
+	}
+	sort.SliceStable(a.outages, func(i, j int) bool { return a.outages[i].start.Before(a.outages[j].start) })
+	a.percentiles = a.stats.getPercentiles(50, 90, 99, 99.9)
+	if len(g.records) > 0 {
+		a.first = g.records[0].Time
+		a.last = g.records[len(g.records)-1].Time
+	}
+	return a
+}
+
+// printAnalysis 输出一组记录的统计、百分位数和中断区间
+func printAnalysis(a *analysis, merged bool) {
+	lang := i18n.T()
+	const layout = "2006-01-02 15:04:05"
+	fmt.Printf(lang.MsgAnalyzeTitle(), a.group.label(merged))
+	if merged {
+		fmt.Printf(lang.MsgAnalyzeFiles(), strings.Join(a.group.files, ", "))
+	}
+	fmt.Printf(lang.MsgAnalyzeRange(), len(a.group.records), a.first.Local().Format(layout),
+		a.last.Local().Format(layout), a.last.Sub(a.first).Round(time.Second))
+
+	sent, responded, minTime, maxTime, avgTime := a.stats.getStats()
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Printf(lang.MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+		p := a.percentiles
+		fmt.Printf(lang.MsgAnalyzePercentiles(), p[0], p[1], p[2], p[3])
+	}
+
+	fmt.Printf(lang.MsgAnalyzeOutages(), len(a.outages))
+	for _, o := range a.outages {
+		end := o.end.Local().Format(layout)
+		if o.ongoing {
+			end = lang.MsgAnalyzeOutageOpen()
+		}
+		fmt.Printf("  %s\n", fmt.Sprintf(lang.MsgTUIOutageEntry(), o.start.Local().Format(layout), end, o.probes,
+			o.end.Sub(o.start).Round(time.Millisecond)))
+	}
+}
+
+// printComparison 在分析多组记录时逐行对比丢包率、百分位数和中断次数
+func printComparison(analyses []*analysis, merged bool) {
+	lang := i18n.T()
+	fmt.Print(lang.MsgAnalyzeCompareTitle())
+	for _, a := range analyses {
+		sent, responded, _, _, _ := a.stats.getStats()
+		fmt.Printf(lang.MsgAnalyzeCompareRow(), a.group.label(merged), sent,
+			float64(sent-responded)/float64(sent)*100, a.percentiles[0], a.percentiles[2],
+			len(a.outages))
+	}
+}
+
+// jsonAnalysis 是 tcping analyze --json 输出的一组记录的统计
+type jsonAnalysis struct {
+	Target      string       `json:"target"`
+	Mode        string       `json:"mode"`
+	Files       []string     `json:"files"`
+	From        time.Time    `json:"from"`
+	To          time.Time    `json:"to"`
+	Sent        int64        `json:"sent"`
+	Received    int64        `json:"received"`
+	Lost        int64        `json:"lost"`
+	LossPercent float64      `json:"loss_percent"`
+	RTT         *jsonRTT     `json:"rtt_ms,omitempty"`
+	Outages     []jsonOutage `json:"outages"`
+}
+
+// jsonOutage 是一次中断区间，open 表示直到记录结束仍未恢复
+type jsonOutage struct {
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Probes     int       `json:"failed_probes"`
+	DurationMs float64   `json:"duration_ms"`
+	Open       bool      `json:"open"`
+}
+
+func (a *analysis) json() jsonAnalysis {
+	sent, responded, minTime, maxTime, avgTime := a.stats.getStats()
+	j := jsonAnalysis{
+		Target:      a.group.target,
+		Mode:        a.group.mode,
+		Files:       a.group.files,
+		From:        a.first,
+		To:          a.last,
+		Sent:        sent,
+		Received:    responded,
+		Lost:        sent - responded,
+		LossPercent: float64(sent-responded) / float64(sent) * 100,
+		Outages:     []jsonOutage{},
+	}
+	if responded > 0 {
+		j.RTT = &jsonRTT{
+			Min: minTime,
+			Avg: avgTime,
+			Max: maxTime,
+			P50: a.percentiles[0],
+			P90: a.percentiles[1],
+			P99: a.percentiles[2],
+		}
+	}
+	for _, o := range a.outages {
+		j.Outages = append(j.Outages, jsonOutage{
+			Start:      o.start,
+			End:        o.end,
+			Probes:     o.probes,
+			DurationMs: durationMillis(o.end.Sub(o.start)),
+			Open:       o.ongoing,
+		})
+	}
+	return j
+}
+
+// printAnalyzeHelp 输出 tcping analyze 的用法和选项
+func printAnalyzeHelp() {
+	lang := i18n.T()
+	fmt.Printf("%s\n\n", lang.UsageAnalyze())
+	options := []struct{ flags, desc string }{
+		{"    --from <time>", lang.OptAnalyzeFrom()},
+		{"    --to <time>", lang.OptAnalyzeTo()},
+		{"    --merge", lang.OptAnalyzeMerge()},
+		{"    --json", lang.OptAnalyzeJSON()},
+		{"-l, --language <code>", lang.OptLanguage()},
+		{"-h, --help", lang.OptHelp()},
+	}
+	printOptionTable(os.Stdout, options)
+}
+
+// runAnalyze 执行 tcping analyze 子命令：读取 --record 保存的文件，
+// 重新计算统计、百分位数和中断区间，多组记录时输出对比
+func runAnalyze(args []string) int {
+	opts := &analyzeOptions{}
+	files, argErr := parseArgs(args, analyzeOptionSpecs(opts))
+	i18n.Initialize(opts.Language)
+	if argErr != nil {
+		handleError(argErr, exitUsage)
+	}
+	if opts.ShowHelp {
+		printAnalyzeHelp()
+		return exitOK
+	}
+	if len(files) == 0 {
+		handleError(errors.New(i18n.T().ErrorAnalyzeNoFiles()), exitUsage)
+	}
+
+	records := make([][]recordEntry, len(files))
+	for i, file := range files {
+		var err error
+		if records[i], err = readRecords(file); err != nil {
+			handleError(err, exitRuntimeError)
+		}
+	}
+	groups := groupRecords(files, records, opts)
+	if len(groups) == 0 {
+		handleError(errors.New(i18n.T().ErrorAnalyzeNoRecords()), exitUsage)
+	}
+
+	analyses := make([]*analysis, len(groups))
+	for i, g := range groups {
+		analyses[i] = analyzeGroup(g)
+	}
+
+	if opts.JSON {
+		out := make([]jsonAnalysis, len(analyses))
+		for i, a := range analyses {
+			out[i] = a.json()
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(out)
+		return exitOK
+	}
+	for _, a := range analyses {
+		printAnalysis(a, opts.Merge)
+	}
+	if len(analyses) > 1 {
+		printComparison(analyses, opts.Merge)
+	}
+	return exitOK
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// writeRecords 把记录写入临时文件，返回文件路径
+func writeRecords(t *testing.T, name string, records ...recordEntry) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	rec, err := newRecorder(path, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range records {
+		if err := rec.enc.Encode(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rec.close()
+	return path
+}
+
+// sampleRun 生成一次每秒探测一次的运行记录，ok 依次表示每次探测是否成功
+func sampleRun(run string, start time.Time, target string, ok ...bool) []recordEntry {
+	var records []recordEntry
+	for i, success := range ok {
+		e := recordEntry{Time: start.Add(time.Duration(i) * time.Second), Run: run, Target: target,
+			Mode: "tcp", Seq: i, Success: success}
+		if success {
+			e.RTT = float64(10 * (i + 1))
+		}
+		records = append(records, e)
+	}
+	return records
+}
+
+func TestAnalyzeGroup(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	records := sampleRun("a", start, "example.com:443", true, false, false, true, true)
+	// 第二次运行以中断结束，不与第一次运行的中断合并
+	records = append(records, sampleRun("b", start.Add(time.Minute), "example.com:443", true, false)...)
+	path := writeRecords(t, "run.jsonl", records...)
+
+	loaded, err := readRecords(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := groupRecords([]string{path}, [][]recordEntry{loaded}, &analyzeOptions{})
+	if len(groups) != 1 {
+		t.Fatalf("groups = %d, want 1", len(groups))
+	}
+	a := analyzeGroup(groups[0])
+	sent, responded, minTime, maxTime, _ := a.stats.getStats()
+	if sent != 7 || responded != 4 || minTime != 10 || maxTime != 50 {
+		t.Errorf("sent/responded/min/max = %d/%d/%v/%v", sent, responded, minTime, maxTime)
+	}
+	if len(a.outages) != 2 {
+		t.Fatalf("outages = %+v, want 2", a.outages)
+	}
+	if o := a.outages[0]; o.probes != 2 || o.ongoing || o.end.Sub(o.start) != 2*time.Second {
+		t.Errorf("first outage = %+v", o)
+	}
+	if !a.outages[1].ongoing {
+		t.Error("an outage at the end of a run should stay open")
+	}
+	if !a.first.Equal(start) || !a.last.Equal(start.Add(time.Minute+time.Second)) {
+		t.Errorf("range = %v - %v", a.first, a.last)
+	}
+}
+
+func TestGroupRecordsWindowAndMerge(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	files := []string{"a.jsonl", "b.jsonl"}
+	records := [][]recordEntry{
+		append(sampleRun("a", start, "x:80", true, true, true), sampleRun("a", start, "y:80", true)...),
+		sampleRun("b", start.Add(time.Hour), "x:80", true, true),
+	}
+
+	groups := groupRecords(files, records, &analyzeOptions{})
+	if len(groups) != 3 {
+		t.Errorf("groups = %d, want one per file and target", len(groups))
+	}
+	groups = groupRecords(files, records, &analyzeOptions{Merge: true})
+	if len(groups) != 2 || len(groups[0].records) != 5 || len(groups[0].files) != 2 {
+		t.Errorf("merged groups = %d, first has %d records from %v", len(groups), len(groups[0].records), groups[0].files)
+	}
+	groups = groupRecords(files, records, &analyzeOptions{From: start.Add(time.Second), To: start.Add(2 * time.Second)})
+	if len(groups) != 1 || len(groups[0].records) != 1 || groups[0].records[0].Seq != 1 {
+		t.Errorf("window should keep only seq 1 of x:80 in a.jsonl: %+v", groups)
+	}
+}
+
+func TestParseRecordTime(t *testing.T) {
+	want := time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local)
+	for _, s := range []string{"2026-01-02 03:04:05", "2026-01-02T03:04:05", want.Format(time.RFC3339)} {
+		got, err := parseRecordTime(s)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("parseRecordTime(%q) = %v, %v", s, got, err)
+		}
+	}
+	if _, err := parseRecordTime("yesterday"); err == nil {
+		t.Error("invalid time should be rejected")
+	}
+}
+
+func TestReadRecordsReportsLine(t *testing.T) {
+	i18n.Initialize("en-US")
+	path := filepath.Join(t.TempDir(), "bad.jsonl")
+	os.WriteFile(path, []byte("{\"seq\":0}\n\nnot json\n"), 0o644)
+	if _, err := readRecords(path); err == nil || !strings.Contains(err.Error(), "bad.jsonl:3") {
+		t.Errorf("error = %v, want file and line number", err)
+	}
+}
@@ -0,0 +1,433 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// optionValue 与 flag.Value 类似，负责把命令行文本写入 Options 字段
+type optionValue interface {
+	Set(string) error
+}
+
+// boolOption 标记不需要参数的开关选项
+type boolOption interface {
+	optionValue
+	isBool() bool
+}
+
+type boolValue bool
+
+func (b *boolValue) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*b = boolValue(v)
+	return nil
+}
+
+func (b *boolValue) isBool() bool { return true }
+
+type intValue int
+
+func (i *intValue) Set(s string) error {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*i = intValue(v)
+	return nil
+}
+
+type stringValue string
+
+func (s *stringValue) Set(v string) error {
+	*s = stringValue(v)
+	return nil
+}
+
+// positiveIntValue 只接受大于零的整数
+type positiveIntValue int
+
+func (i *positiveIntValue) Set(s string) error {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	if v < 1 {
+		return errors.New("value must be positive")
+	}
+	*i = positiveIntValue(v)
+	return nil
+}
+
+// byteSizeValue 解析字节数，支持 K/M/G 后缀 (按 1024 进制)，如 512K、10M、1GiB
+type byteSizeValue int64
+
+func (b *byteSizeValue) Set(s string) error {
+	v, err := parseByteSize(s)
+	if err != nil {
+		return err
+	}
+	*b = byteSizeValue(v)
+	return nil
+}
+
+func parseByteSize(s string) (int64, error) {
+	num := strings.ToUpper(strings.TrimSpace(s))
+	num = strings.TrimSuffix(strings.TrimSuffix(num, "B"), "I")
+	multiplier := int64(1)
+	if n := len(num); n > 0 {
+		switch num[n-1] {
+		case 'K':
+			multiplier = 1 << 10
+		case 'M':
+			multiplier = 1 << 20
+		case 'G':
+			multiplier = 1 << 30
+		}
+		if multiplier > 1 {
+			num = num[:n-1]
+		}
+	}
+	v, err := strconv.ParseInt(num, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 || v > (1<<62)/multiplier {
+		return 0, errors.New("size out of range")
+	}
+	return v * multiplier, nil
+}
+
+// durationValue 解析 Go 风格的时长 (如 60s、1m30s)，不接受负值
+type durationValue time.Duration
+
+func (d *durationValue) Set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	if v < 0 {
+		return errors.New("negative duration")
+	}
+	*d = durationValue(v)
+	return nil
+}
+
+// funcValue 用于需要自定义解析逻辑的选项
+type funcValue func(string) error
+
+func (f funcValue) Set(s string) error { return f(s) }
+
+type optionSpec struct {
+	short byte   // 短选项字符，0 表示没有短选项
+	long  string // 长选项名称
+	value optionValue
+}
+
+func isBoolSpec(spec *optionSpec) bool {
+	b, ok := spec.value.(boolOption)
+	return ok && b.isBool()
+}
+
+// 所有命令行选项的定义，短选项和长选项共享同一个目标字段
+func optionSpecs(opts *Options) []optionSpec {
+	return []optionSpec{
+		{'4', "ipv4", (*boolValue)(&opts.UseIPv4)},
+		{'6', "ipv6", (*boolValue)(&opts.UseIPv6)},
+		{'n', "count", (*intValue)(&opts.Count)},
+		{'t', "interval", (*intValue)(&opts.Interval)},
+		{0, "interval-jitter", (*durationValue)(&opts.IntervalJitter)},
+		{0, "max-inflight", (*positiveIntValue)(&opts.MaxInFlight)},
+		{0, "adaptive", (*boolValue)(&opts.Adaptive)},
+		{0, "record", (*stringValue)(&opts.Record)},
+		{'w', "timeout", (*intValue)(&opts.Timeout)},
+		{'p', "port", (*intValue)(&opts.Port)},
+		{'c', "color", (*boolValue)(&opts.ColorOutput)},
+		{'v', "verbose", (*boolValue)(&opts.VerboseMode)},
+		{'H', "http", (*boolValue)(&opts.HTTPMode)},
+		{'k', "insecure", (*boolValue)(&opts.InsecureSSL)},
+		{0, "ws", (*boolValue)(&opts.WebSocket)},
+		{0, "grpc", (*boolValue)(&opts.GRPC)},
+		{0, "grpc-service", (*stringValue)(&opts.GRPCService)},
+		{0, "proto", funcValue(func(s string) (err error) {
+			opts.Proto, err = parseProto(s)
+			return err
+		})},
+		{0, "dns", (*boolValue)(&opts.DNS)},
+		{0, "dns-name", (*stringValue)(&opts.DNSName)},
+		{0, "dns-type", funcValue(func(s string) (err error) {
+			opts.DNSType, err = parseDNSType(s)
+			return err
+		})},
+		{0, "dns-transport", funcValue(func(s string) (err error) {
+			opts.DNSTransport, err = parseDNSTransport(s)
+			return err
+		})},
+		{0, "ntp", (*boolValue)(&opts.NTP)},
+		{0, "persistent", (*boolValue)(&opts.Persistent)},
+		{0, "find-idle-timeout", (*boolValue)(&opts.FindIdleTimeout)},
+		{0, "idle-min", (*durationValue)(&opts.IdleMin)},
+		{0, "idle-max", (*durationValue)(&opts.IdleMax)},
+		{0, "send", funcValue(func(s string) (err error) {
+			opts.Send, err = unescapePayload(s)
+			return err
+		})},
+		{0, "expect", funcValue(func(s string) (err error) {
+			opts.Expect, err = unescapePayload(s)
+			return err
+		})},
+		{0, "icmp", (*boolValue)(&opts.ICMP)},
+		{0, "compare-icmp", (*boolValue)(&opts.CompareICMP)},
+		{0, "rate", funcValue(func(s string) (err error) {
+			opts.Rate, err = parseRate(s)
+			return err
+		})},
+		{0, "concurrency", (*positiveIntValue)(&opts.Concurrency)},
+		{0, "tui", (*boolValue)(&opts.TUI)},
+		{0, "summary-every", (*durationValue)(&opts.SummaryEvery)},
+		{0, "summary-window", (*boolValue)(&opts.SummaryWindow)},
+		{0, "duration", (*durationValue)(&opts.Duration)},
+		{0, "until-success", (*boolValue)(&opts.UntilSuccess)},
+		{0, "until-failure", (*boolValue)(&opts.UntilFailure)},
+		{0, "max-failures", (*intValue)(&opts.MaxFailures)},
+		{0, "max-loss", funcValue(func(s string) (err error) {
+			opts.MaxLoss, err = parseLossPercent(s)
+			return err
+		})},
+		{0, "max-avg-rtt", (*durationValue)(&opts.MaxAvgRTT)},
+		{0, "max-p99-rtt", (*durationValue)(&opts.MaxP99RTT)},
+		{0, "json", (*boolValue)(&opts.JSON)},
+		{0, "throughput", (*boolValue)(&opts.Throughput)},
+		{0, "upload-size", (*byteSizeValue)(&opts.UploadSize)},
+		{0, "streams", (*positiveIntValue)(&opts.Streams)},
+		{0, "http-reuse", (*boolValue)(&opts.HTTPReuse)},
+		{0, "http-fresh", (*boolValue)(&opts.HTTPFresh)},
+		{0, "http2", (*boolValue)(&opts.HTTP2)},
+		{0, "http3", (*boolValue)(&opts.HTTP3)},
+		{'L', "follow", (*boolValue)(&opts.Follow)},
+		{0, "max-redirs", (*intValue)(&opts.MaxRedirs)},
+		{0, "cacert", (*stringValue)(&opts.CACert)},
+		{0, "cert", (*stringValue)(&opts.Cert)},
+		{0, "key", (*stringValue)(&opts.Key)},
+		{0, "tls-min", funcValue(func(s string) (err error) {
+			opts.TLSMin, err = parseTLSVersion(s)
+			return err
+		})},
+		{0, "tls-max", funcValue(func(s string) (err error) {
+			opts.TLSMax, err = parseTLSVersion(s)
+			return err
+		})},
+		{0, "ciphers", funcValue(func(s string) (err error) {
+			opts.Ciphers, err = parseCipherSuites(s)
+			return err
+		})},
+		{0, "sni", (*stringValue)(&opts.SNI)},
+		{0, "proxy", (*stringValue)(&opts.Proxy)},
+		{0, "proxy-env", (*boolValue)(&opts.ProxyEnv)},
+		{0, "unix-socket", (*stringValue)(&opts.UnixSocket)},
+		{'l', "language", (*stringValue)(&opts.Language)},
+		{'V', "version", (*boolValue)(&opts.ShowVersion)},
+		{'h', "help", (*boolValue)(&opts.ShowHelp)},
+	}
+}
+
+type argErrorKind int
+
+const (
+	argErrUnknownOption argErrorKind = iota
+	argErrMissingValue
+	argErrInvalidValue
+	argErrUnexpectedValue
+)
+
+// argError 参数解析错误，在输出时才根据当前语言格式化，
+// 这样 -l 选项即使出现在错误参数之后也能生效（见 languageAfterError）
+type argError struct {
+	kind  argErrorKind
+	name  string // 用户输入的选项形式，如 -n 或 --count
+	value string
+}
+
+func (e *argError) Error() string {
+	lang := i18n.T()
+	var msg string
+	switch e.kind {
+	case argErrUnknownOption:
+		msg = fmt.Sprintf(lang.ErrorUnknownOption(), e.name)
+	case argErrMissingValue:
+		msg = fmt.Sprintf(lang.ErrorOptionRequiresValue(), e.name)
+	case argErrInvalidValue:
+		msg = fmt.Sprintf(lang.ErrorInvalidOptionValue(), e.name, e.value)
+	case argErrUnexpectedValue:
+		msg = fmt.Sprintf(lang.ErrorOptionTakesNoValue(), e.name)
+	}
+	return msg + "\n" + lang.ErrorTryHelp()
+}
+
+// parseArgs 按 GNU 风格解析参数：支持组合短选项(-4cv)、-n5、--count=5、
+// 选项出现在位置参数之后以及 -- 终止选项解析，返回位置参数
+func parseArgs(args []string, specs []optionSpec) ([]string, error) {
+	shortIndex := make(map[byte]*optionSpec, len(specs))
+	longIndex := make(map[string]*optionSpec, len(specs))
+	for i := range specs {
+		if specs[i].short != 0 {
+			shortIndex[specs[i].short] = &specs[i]
+		}
+		longIndex[specs[i].long] = &specs[i]
+	}
+
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		switch {
+		case arg == "--":
+			return append(positional, args[i+1:]...), nil
+
+		case strings.HasPrefix(arg, "--"):
+			name, value, hasValue := strings.Cut(arg[2:], "=")
+			spec, ok := longIndex[name]
+			if !ok {
+				return nil, languageAfterError(&argError{kind: argErrUnknownOption, name: "--" + name}, args[i+1:], shortIndex, longIndex)
+			}
+			consumed, err := applyOption(spec, "--"+name, value, hasValue, args[i+1:])
+			if err != nil {
+				return nil, languageAfterError(err, args[i+1:], shortIndex, longIndex)
+			}
+			i += consumed
+
+		case len(arg) > 1 && arg[0] == '-':
+			// 兼容旧版 flag 包的单横线长选项写法，如 -count=5、-http
+			if name, value, hasValue := strings.Cut(arg[1:], "="); len(name) > 1 {
+				if spec, ok := longIndex[name]; ok {
+					consumed, err := applyOption(spec, "-"+name, value, hasValue, args[i+1:])
+					if err != nil {
+						return nil, languageAfterError(err, args[i+1:], shortIndex, longIndex)
+					}
+					i += consumed
+					continue
+				}
+			}
+
+			consumed, err := applyShortGroup(arg[1:], shortIndex, args[i+1:])
+			if err != nil {
+				return nil, languageAfterError(err, args[i+1:], shortIndex, longIndex)
+			}
+			i += consumed
+
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	return positional, nil
+}
+
+// languageAfterError 在解析出错后继续在其余参数中查找 -l/--language，
+// 使错误信息按之后指定的语言输出，返回原来的错误。其余参数按与 parseArgs 相同的规则拆分，
+// 组合短选项（如 -cl en）中的 -l 也能识别，其他选项只跳过其参数而不赋值
+func languageAfterError(err error, rest []string, shortIndex map[byte]*optionSpec, longIndex map[string]*optionSpec) error {
+	language, ok := longIndex["language"]
+	if !ok {
+		return err
+	}
+	languageOnly := func(spec *optionSpec, name, value string, hasValue bool, rest []string) (int, error) {
+		if spec == language {
+			return applyOption(spec, name, value, hasValue, rest)
+		}
+		if isBoolSpec(spec) || hasValue {
+			return 0, nil
+		}
+		if len(rest) == 0 {
+			return 0, &argError{kind: argErrMissingValue, name: name}
+		}
+		return 1, nil
+	}
+	for j := 0; j < len(rest); j++ {
+		arg := rest[j]
+		var consumed int
+		switch {
+		case arg == "--":
+			return err
+		case strings.HasPrefix(arg, "--"):
+			name, value, hasValue := strings.Cut(arg[2:], "=")
+			if spec, ok := longIndex[name]; ok {
+				consumed, _ = languageOnly(spec, "--"+name, value, hasValue, rest[j+1:])
+			}
+		case len(arg) > 1 && arg[0] == '-':
+			if name, value, hasValue := strings.Cut(arg[1:], "="); len(name) > 1 {
+				if spec, ok := longIndex[name]; ok {
+					consumed, _ = languageOnly(spec, "-"+name, value, hasValue, rest[j+1:])
+					break
+				}
+			}
+			// 后续参数中的错误不影响原来的错误，只需要知道消耗了几个参数
+			consumed, _ = scanShortGroup(arg[1:], shortIndex, rest[j+1:], languageOnly)
+		}
+		j += consumed
+	}
+	return err
+}
+
+// applyShortGroup 处理一组短选项，返回额外消耗的参数个数
+func applyShortGroup(group string, shortIndex map[byte]*optionSpec, rest []string) (int, error) {
+	return scanShortGroup(group, shortIndex, rest, applyOption)
+}
+
+// scanShortGroup 将一组短选项拆分为单个选项及其参数，依次交给 apply 处理，返回额外消耗的参数个数
+func scanShortGroup(group string, shortIndex map[byte]*optionSpec, rest []string,
+	apply func(spec *optionSpec, name, value string, hasValue bool, rest []string) (int, error)) (int, error) {
+	for j := 0; j < len(group); j++ {
+		name := "-" + group[j:j+1]
+		spec, ok := shortIndex[group[j]]
+		if !ok {
+			return 0, &argError{kind: argErrUnknownOption, name: name}
+		}
+		if isBoolSpec(spec) {
+			if _, err := apply(spec, name, "", false, nil); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		// 需要参数的短选项：同组剩余部分即为参数值，否则取下一个参数
+		if j+1 < len(group) {
+			_, err := apply(spec, name, group[j+1:], true, nil)
+			return 0, err
+		}
+		return apply(spec, name, "", false, rest)
+	}
+	return 0, nil
+}
+
+// applyOption 为单个选项赋值，返回额外消耗的参数个数
+func applyOption(spec *optionSpec, name, value string, hasValue bool, rest []string) (int, error) {
+	if isBoolSpec(spec) {
+		if !hasValue {
+			value = "true"
+		}
+		if err := spec.value.Set(value); err != nil {
+			return 0, &argError{kind: argErrUnexpectedValue, name: name}
+		}
+		return 0, nil
+	}
+
+	consumed := 0
+	if !hasValue {
+		if len(rest) == 0 {
+			return 0, &argError{kind: argErrMissingValue, name: name}
+		}
+		value = rest[0]
+		consumed = 1
+	}
+	if err := spec.value.Set(value); err != nil {
+		return 0, &argError{kind: argErrInvalidValue, name: name, value: value}
+	}
+	return consumed, nil
+}
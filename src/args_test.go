@@ -0,0 +1,168 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"tcping/src/i18n"
+)
+
+func TestSetupFlagsDefaults(t *testing.T) {
+	opts := &Options{}
+	args, err := setupFlags(opts, []string{"example.com"})
+	if err != nil {
+		t.Fatalf("setupFlags error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"example.com"}) {
+		t.Errorf("positional = %v", args)
+	}
+	if opts.Count != 4 || opts.Interval != 1000 || opts.Timeout != 1000 {
+		t.Errorf("defaults = count %d interval %d timeout %d", opts.Count, opts.Interval, opts.Timeout)
+	}
+}
+
+func TestParseArgsGNUStyle(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		check func(*Options) bool
+		want  []string
+	}{
+		{"bundled short flags", []string{"-4cv", "host"},
+			func(o *Options) bool { return o.UseIPv4 && o.ColorOutput && o.VerboseMode }, []string{"host"}},
+		{"options after positionals", []string{"host", "443", "-n", "5"},
+			func(o *Options) bool { return o.Count == 5 }, []string{"host", "443"}},
+		{"attached short value", []string{"-n5", "host"},
+			func(o *Options) bool { return o.Count == 5 }, []string{"host"}},
+		{"bundled flags ending with value option", []string{"-cvn", "3", "host"},
+			func(o *Options) bool { return o.ColorOutput && o.VerboseMode && o.Count == 3 }, []string{"host"}},
+		{"long option with equals", []string{"--count=7", "--interval", "200", "host"},
+			func(o *Options) bool { return o.Count == 7 && o.Interval == 200 }, []string{"host"}},
+		{"double dash terminates options", []string{"-n", "2", "--", "-host", "-v"},
+			func(o *Options) bool { return o.Count == 2 && !o.VerboseMode }, []string{"-host", "-v"}},
+		{"legacy single dash long option", []string{"-count=3", "-http", "https://example.com"},
+			func(o *Options) bool { return o.Count == 3 && o.HTTPMode }, []string{"https://example.com"}},
+		{"bool option with explicit value", []string{"--color=false", "host"},
+			func(o *Options) bool { return !o.ColorOutput }, []string{"host"}},
+		{"language value", []string{"host", "-l", "ja-JP"},
+			func(o *Options) bool { return o.Language == "ja-JP" }, []string{"host"}},
+	}
+	for _, tt := range tests {
+		opts := &Options{}
+		args, err := parseArgs(tt.args, optionSpecs(opts))
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(args, tt.want) {
+			t.Errorf("%s: positional = %v, want %v", tt.name, args, tt.want)
+		}
+		if !tt.check(opts) {
+			t.Errorf("%s: options not applied: %+v", tt.name, opts)
+		}
+	}
+}
+
+func TestParseArgsErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		kind argErrorKind
+	}{
+		{[]string{"--nope"}, argErrUnknownOption},
+		{[]string{"-4x"}, argErrUnknownOption},
+		{[]string{"host", "-n"}, argErrMissingValue},
+		{[]string{"--count"}, argErrMissingValue},
+		{[]string{"-n", "abc"}, argErrInvalidValue},
+		{[]string{"--timeout=fast"}, argErrInvalidValue},
+		{[]string{"--verbose=yes please"}, argErrUnexpectedValue},
+		{[]string{"--streams", "0"}, argErrInvalidValue},
+		{[]string{"--upload-size", "10X"}, argErrInvalidValue},
+	}
+	for _, tt := range tests {
+		_, err := parseArgs(tt.args, optionSpecs(&Options{}))
+		var ae *argError
+		if !errors.As(err, &ae) {
+			t.Errorf("parseArgs(%v) error = %v, want argError", tt.args, err)
+			continue
+		}
+		if ae.kind != tt.kind {
+			t.Errorf("parseArgs(%v) kind = %v, want %v", tt.args, ae.kind, tt.kind)
+		}
+		if ae.Error() == "" {
+			t.Errorf("parseArgs(%v) produced empty message", tt.args)
+		}
+	}
+}
+
+func TestLanguageAfterBadArgument(t *testing.T) {
+	defer i18n.Initialize("en-US")
+	for _, args := range [][]string{
+		{"--nope", "-l", "zh-CN"},
+		{"-l", "zh-CN", "--nope"},
+		{"-4x", "--language=zh-CN"},
+		{"-n", "abc", "-lzh-CN", "host"},
+		{"--nope", "-cl", "zh-CN"},
+		{"--nope", "-vlzh-CN"},
+	} {
+		opts := &Options{}
+		_, err := parseArgs(args, optionSpecs(opts))
+		if err == nil || opts.Language != "zh-CN" {
+			t.Errorf("parseArgs(%v): error = %v, language = %q", args, err, opts.Language)
+			continue
+		}
+		i18n.Initialize(opts.Language)
+		if !strings.Contains(err.Error(), "尝试") {
+			t.Errorf("parseArgs(%v) error not localized: %v", args, err)
+		}
+	}
+
+	// -- 之后的参数不是选项
+	opts := &Options{}
+	parseArgs([]string{"--nope", "--", "-l", "zh-CN"}, optionSpecs(opts))
+	if opts.Language != "" {
+		t.Errorf("language after -- applied: %q", opts.Language)
+	}
+
+	// 选项的参数不是选项：-w 的参数 -l 不能被当作语言选项
+	opts = &Options{}
+	parseArgs([]string{"--nope", "-w", "-l", "zh-CN"}, optionSpecs(opts))
+	if opts.Language != "" {
+		t.Errorf("option value taken as language: %q", opts.Language)
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{"1024", 1024, false},
+		{"512K", 512 << 10, false},
+		{"10M", 10 << 20, false},
+		{"10MB", 10 << 20, false},
+		{"1GiB", 1 << 30, false},
+		{"2g", 2 << 30, false},
+		{"", 0, true},
+		{"-1M", 0, true},
+		{"abc", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseByteSize(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseByteSize(%q) = %d, %v; want %d, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestUploadSizeImpliesThroughput(t *testing.T) {
+	opts := &Options{}
+	if _, err := setupFlags(opts, []string{"-H", "--upload-size", "1M", "http://example.com"}); err != nil {
+		t.Fatalf("setupFlags error: %v", err)
+	}
+	if !opts.Throughput || opts.UploadSize != 1<<20 || opts.Streams != 1 {
+		t.Errorf("throughput options = %v %d %d", opts.Throughput, opts.UploadSize, opts.Streams)
+	}
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// DNS 查询使用的传输方式
+const (
+	dnsUDP = "udp"
+	dnsTCP = "tcp"
+	dnsDoT = "dot" // DNS over TLS (RFC 7858)
+)
+
+// DNS 模式的默认查询：根区的 NS 记录，任何递归解析器都能回答
+const (
+	defaultDNSName = "."
+	defaultDNSType = 2 // NS
+)
+
+// dnsTypes 是常用记录类型的名称
+var dnsTypes = map[string]uint16{
+	"A": 1, "NS": 2, "CNAME": 5, "SOA": 6, "PTR": 12, "MX": 15, "TXT": 16,
+	"AAAA": 28, "SRV": 33, "DS": 43, "DNSKEY": 48, "HTTPS": 65, "ANY": 255, "CAA": 257,
+}
+
+// dnsRcodes 是响应码的名称 (RFC 1035, RFC 6895)
+var dnsRcodes = []string{"NOERROR", "FORMERR", "SERVFAIL", "NXDOMAIN", "NOTIMP", "REFUSED"}
+
+// parseDNSType 解析记录类型名称（如 AAAA）或数值（如 65、TYPE65）
+func parseDNSType(s string) (uint16, error) {
+	name := strings.ToUpper(s)
+	if t, ok := dnsTypes[name]; ok {
+		return t, nil
+	}
+	n, err := strconv.ParseUint(strings.TrimPrefix(name, "TYPE"), 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("unknown DNS record type %q", s)
+	}
+	return uint16(n), nil
+}
+
+// parseDNSTransport 检查 --dns-transport 的取值
+func parseDNSTransport(s string) (string, error) {
+	switch t := strings.ToLower(s); t {
+	case dnsUDP, dnsTCP, dnsDoT:
+		return t, nil
+	}
+	return "", fmt.Errorf("unknown DNS transport %q", s)
+}
+
+// dnsTypeName 返回记录类型的名称，未知类型按 RFC 3597 写成 TYPEn
+func dnsTypeName(t uint16) string {
+	for name, v := range dnsTypes {
+		if v == t {
+			return name
+		}
+	}
+	return "TYPE" + strconv.Itoa(int(t))
+}
+
+// dnsRcodeName 返回响应码的名称
+func dnsRcodeName(rcode int) string {
+	if rcode < len(dnsRcodes) {
+		return dnsRcodes[rcode]
+	}
+	return "RCODE" + strconv.Itoa(rcode)
+}
+
+// buildDNSQuery 构造一个请求递归解析的查询报文
+func buildDNSQuery(id uint16, name string, qtype uint16) ([]byte, error) {
+	msg := make([]byte, 12, 512)
+	binary.BigEndian.PutUint16(msg[0:], id)
+	binary.BigEndian.PutUint16(msg[2:], 0x0100) // RD
+	binary.BigEndian.PutUint16(msg[4:], 1)      // QDCOUNT
+	if name = strings.TrimSuffix(name, "."); name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if len(label) == 0 || len(label) > 63 {
+				return nil, fmt.Errorf(i18n.T().ErrorDNSName(), name)
+			}
+			msg = append(msg, byte(len(label)))
+			msg = append(msg, label...)
+		}
+	}
+	if len(msg)-12 > 254 {
+		return nil, fmt.Errorf(i18n.T().ErrorDNSName(), name)
+	}
+	msg = append(msg, 0)
+	msg = binary.BigEndian.AppendUint16(msg, qtype)
+	msg = binary.BigEndian.AppendUint16(msg, 1) // IN
+	return msg, nil
+}
+
+// dnsReply 是从响应头部读取的结果
+type dnsReply struct {
+	rcode     int
+	answers   int
+	truncated bool
+}
+
+// parseDNSReply 检查响应头部，id 与查询不符时返回 ok=false
+func parseDNSReply(msg []byte, id uint16) (reply dnsReply, ok bool) {
+	if len(msg) < 12 || binary.BigEndian.Uint16(msg) != id {
+		return reply, false
+	}
+	flags := binary.BigEndian.Uint16(msg[2:])
+	if flags&0x8000 == 0 { // QR 必须为 1
+		return reply, false
+	}
+	reply.rcode = int(flags & 0x000f)
+	reply.truncated = flags&0x0200 != 0
+	reply.answers = int(binary.BigEndian.Uint16(msg[6:]))
+	return reply, true
+}
+
+// dnsExchange 通过指定的传输方式发送查询并等待 ID 相符的响应，trace 记录连接和 TLS 握手耗时
+func dnsExchange(ctx context.Context, addr, serverName string, query []byte, opts *Options, trace *connTrace) (dnsReply, error) {
+	id := binary.BigEndian.Uint16(query)
+	dialer := &net.Dialer{}
+	network := "tcp"
+	if opts.DNSTransport == dnsUDP {
+		network = "udp"
+	}
+	connectStart := time.Now()
+	conn, err := dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		return dnsReply{}, err
+	}
+	defer conn.Close()
+	trace.connectStart, trace.connectDone = connectStart, time.Now()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	if opts.DNSTransport == dnsDoT {
+		cfg := probeTLSConfig(opts)
+		if cfg.ServerName == "" {
+			cfg.ServerName = serverName
+		}
+		tlsConn := tls.Client(conn, cfg)
+		trace.tlsStart = time.Now()
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return dnsReply{}, err
+		}
+		trace.tlsDone = time.Now()
+		conn = tlsConn
+	}
+
+	if network == "udp" {
+		if _, err := conn.Write(query); err != nil {
+			return dnsReply{}, err
+		}
+		buf := make([]byte, 65535)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return dnsReply{}, err
+			}
+			// 忽略之前超时的查询迟到的响应
+			if reply, ok := parseDNSReply(buf[:n], id); ok {
+				return reply, nil
+			}
+		}
+	}
+
+	// TCP 和 TLS 上的报文带两字节长度前缀
+	framed := binary.BigEndian.AppendUint16(nil, uint16(len(query)))
+	if _, err := conn.Write(append(framed, query...)); err != nil {
+		return dnsReply{}, err
+	}
+	var size [2]byte
+	if _, err := io.ReadFull(conn, size[:]); err != nil {
+		return dnsReply{}, err
+	}
+	msg := make([]byte, binary.BigEndian.Uint16(size[:]))
+	if _, err := io.ReadFull(conn, msg); err != nil {
+		return dnsReply{}, err
+	}
+	reply, ok := parseDNSReply(msg, id)
+	if !ok {
+		return dnsReply{}, errors.New(i18n.T().ErrorDNSReply())
+	}
+	return reply, nil
+}
+
+// dnsPingOnce 向 address:port 的 DNS 服务器发送一次查询。NOERROR 和 NXDOMAIN 视为成功，
+// SERVFAIL、REFUSED 等响应码说明解析器工作不正常，视为失败
+func dnsPingOnce(ctx context.Context, address, port, serverName string, stats *Statistics, seq int, ip string, opts *Options) probeResult {
+	lang := i18n.T()
+	result := probeResult{seq: seq, time: time.Now()}
+	endpoint := net.JoinHostPort(ip, port)
+	fail := func(elapsed float64, err error) probeResult {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			fmt.Fprint(output, infoText(lang.MsgOperationCanceled(), opts.ColorOutput))
+			result.canceled = true
+			return result
+		}
+		stats.update(elapsed, false)
+		fmt.Fprint(output, errorText(fmt.Sprintf(lang.MsgDNSFailed(), endpoint, seq, err), opts.ColorOutput))
+		result.rtt = elapsed
+		result.err = err.Error()
+		return result
+	}
+
+	var idBytes [2]byte
+	rand.Read(idBytes[:])
+	id := binary.BigEndian.Uint16(idBytes[:])
+	query, err := buildDNSQuery(id, opts.DNSName, opts.DNSType)
+	if err != nil {
+		return fail(0, err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, probeTimeout(ctx, opts))
+	defer cancel()
+	trace := &connTrace{}
+	start := time.Now()
+	// address 可能是带方括号的 IPv6 地址
+	reply, err := dnsExchange(queryCtx, net.JoinHostPort(strings.Trim(address, "[]"), port), serverName, query, opts, trace)
+	elapsed := durationMillis(time.Since(start))
+	if err != nil {
+		if reason := describeTLSError(err); reason != "" {
+			err = errors.New(reason)
+		}
+		return fail(elapsed, err)
+	}
+
+	rcode := dnsRcodeName(reply.rcode)
+	success := rcode == "NOERROR" || rcode == "NXDOMAIN"
+	stats.update(elapsed, success)
+	stats.updateDNS(rcode, reply.truncated)
+	result.rtt = elapsed
+	result.success = success
+	if !success {
+		result.err = "rcode=" + rcode
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "DNS %s: seq=%d rcode=%s answers=%d", endpoint, seq, rcode, reply.answers)
+	if reply.truncated {
+		msg.WriteString(" truncated")
+	}
+	fmt.Fprintf(&msg, " proto=%s time=%.2fms\n", opts.DNSTransport, elapsed)
+	if success {
+		fmt.Fprint(output, successText(msg.String(), opts.ColorOutput))
+	} else {
+		fmt.Fprint(output, errorText(msg.String(), opts.ColorOutput))
+	}
+	if opts.VerboseMode && opts.DNSTransport != dnsUDP {
+		fmt.Fprintf(output, lang.MsgVerboseDNSConn(), trace.connectMillis(), trace.tlsMillis())
+	}
+	return result
+}
+
+// updateDNS 记录一次 DNS 响应的响应码以及是否被截断
+func (s *Statistics) updateDNS(rcode string, truncated bool) {
+	s.Lock()
+	defer s.Unlock()
+	if s.dnsRcodes == nil {
+		s.dnsRcodes = make(map[string]int64)
+	}
+	s.dnsRcodes[rcode]++
+	if truncated {
+		s.dnsTruncated++
+	}
+}
+
+// getDNSStats 返回各响应码的次数和被截断的响应数
+func (s *Statistics) getDNSStats() (map[string]int64, int64) {
+	s.RLock()
+	defer s.RUnlock()
+	rcodes := make(map[string]int64, len(s.dnsRcodes))
+	for k, v := range s.dnsRcodes {
+		rcodes[k] = v
+	}
+	return rcodes, s.dnsTruncated
+}
+
+// printDNSStatistics 输出往返时间统计、各响应码的次数和被截断的响应数
+func printDNSStatistics(stats *Statistics) {
+	lang := i18n.T()
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Print(lang.MsgDNSStatisticsTitle())
+	if sent == 0 {
+		return
+	}
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Printf(lang.MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+	rcodes, truncated := stats.getDNSStats()
+	names := make([]string, 0, len(rcodes))
+	for name := range rcodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf(lang.MsgStatisticsDNSRcode(), name, rcodes[name])
+	}
+	if truncated > 0 {
+		fmt.Printf(lang.MsgStatisticsDNSTruncated(), truncated)
+	}
+}
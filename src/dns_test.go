@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestBuildDNSQuery(t *testing.T) {
+	msg, err := buildDNSQuery(0x1234, "example.com.", 28)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "\x12\x34\x01\x00\x00\x01\x00\x00\x00\x00\x00\x00\x07example\x03com\x00\x00\x1c\x00\x01"
+	if string(msg) != want {
+		t.Errorf("buildDNSQuery = %q, want %q", msg, want)
+	}
+	if root, _ := buildDNSQuery(1, ".", 2); len(root) != 12+5 {
+		t.Errorf("root query has %d bytes, want 17", len(root))
+	}
+	for _, bad := range []string{"a..b", strings.Repeat("x", 64) + ".com"} {
+		if _, err := buildDNSQuery(1, bad, 1); err == nil {
+			t.Errorf("buildDNSQuery(%q) should fail", bad)
+		}
+	}
+}
+
+func TestParseDNSType(t *testing.T) {
+	tests := []struct {
+		in   string
+		want uint16
+	}{
+		{"aaaa", 28}, {"MX", 15}, {"65", 65}, {"TYPE64", 64},
+	}
+	for _, tt := range tests {
+		if got, err := parseDNSType(tt.in); err != nil || got != tt.want {
+			t.Errorf("parseDNSType(%q) = %d, %v", tt.in, got, err)
+		}
+	}
+	if _, err := parseDNSType("BOGUS"); err == nil {
+		t.Error("parseDNSType should reject unknown names")
+	}
+}
+
+// dnsAnswer 根据查询构造响应头部，flags 中包含 rcode 和 TC 标志
+func dnsAnswer(query []byte, flags uint16, answers uint16) []byte {
+	reply := append([]byte(nil), query...)
+	binary.BigEndian.PutUint16(reply[2:], 0x8180|flags)
+	binary.BigEndian.PutUint16(reply[6:], answers)
+	return reply
+}
+
+// startUDPDNS 启动 UDP DNS 服务端，先发送一个 ID 不符的响应再发送正确的响应
+func startUDPDNS(t *testing.T, flags, answers uint16) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { pc.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			stale := dnsAnswer(buf[:n], 0, 0)
+			stale[0] ^= 0xff
+			pc.WriteTo(stale, addr)
+			pc.WriteTo(dnsAnswer(buf[:n], flags, answers), addr)
+		}
+	}()
+	_, port, _ := net.SplitHostPort(pc.LocalAddr().String())
+	return port
+}
+
+// serveStreamDNS 在 TCP 或 TLS 监听上回答带长度前缀的查询
+func serveStreamDNS(ln net.Listener, flags, answers uint16) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			var size [2]byte
+			if _, err := io.ReadFull(conn, size[:]); err != nil {
+				return
+			}
+			query := make([]byte, binary.BigEndian.Uint16(size[:]))
+			if _, err := io.ReadFull(conn, query); err != nil {
+				return
+			}
+			reply := dnsAnswer(query, flags, answers)
+			conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(reply))), reply...))
+		}()
+	}
+}
+
+// dnsProbe 对 127.0.0.1:port 执行一次 DNS 探测
+func dnsProbe(t *testing.T, port string, opts *Options) (probeResult, *Statistics, string) {
+	t.Helper()
+	opts.DNS = true
+	if opts.Timeout == 0 {
+		opts.Timeout = 2000
+	}
+	if opts.DNSName == "" {
+		opts.DNSName = "example.com"
+	}
+	if opts.DNSType == 0 {
+		opts.DNSType = 1
+	}
+	if err := loadTLSConfig(opts); err != nil {
+		t.Fatal(err)
+	}
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	r := dnsPingOnce(context.Background(), "127.0.0.1", port, "127.0.0.1", stats, 0, "127.0.0.1", opts)
+	return r, stats, buf.String()
+}
+
+func TestDNSPingOnceUDP(t *testing.T) {
+	r, stats, out := dnsProbe(t, startUDPDNS(t, 0, 2), &Options{DNSTransport: dnsUDP})
+	if !r.success || !strings.Contains(out, "rcode=NOERROR answers=2 proto=udp") {
+		t.Errorf("success = %v, err %q, output %q", r.success, r.err, out)
+	}
+	if rcodes, _ := stats.getDNSStats(); rcodes["NOERROR"] != 1 {
+		t.Errorf("rcodes = %v", rcodes)
+	}
+
+	// 截断的 NXDOMAIN 响应仍然是有效的回答
+	r, stats, out = dnsProbe(t, startUDPDNS(t, 0x0200|3, 0), &Options{DNSTransport: dnsUDP})
+	if !r.success || !strings.Contains(out, "rcode=NXDOMAIN answers=0 truncated") {
+		t.Errorf("success = %v, output %q", r.success, out)
+	}
+	if _, truncated := stats.getDNSStats(); truncated != 1 {
+		t.Errorf("truncated = %d, want 1", truncated)
+	}
+
+	r, _, _ = dnsProbe(t, startUDPDNS(t, 2, 0), &Options{DNSTransport: dnsUDP})
+	if r.success || r.err != "rcode=SERVFAIL" {
+		t.Errorf("SERVFAIL: success = %v, err %q", r.success, r.err)
+	}
+}
+
+func TestDNSPingOnceTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go serveStreamDNS(ln, 0, 1)
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+
+	r, _, out := dnsProbe(t, port, &Options{DNSTransport: dnsTCP})
+	if !r.success || !strings.Contains(out, "answers=1 proto=tcp") {
+		t.Errorf("success = %v, err %q, output %q", r.success, r.err, out)
+	}
+}
+
+func TestDNSPingOnceDoT(t *testing.T) {
+	// 借用 httptest 生成的 127.0.0.1 证书
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	srv.Close()
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: srv.TLS.Certificates})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go serveStreamDNS(ln, 0, 1)
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+
+	r, _, out := dnsProbe(t, port, &Options{DNSTransport: dnsDoT, CACert: serverCAFile(t, srv), VerboseMode: true})
+	if !r.success || !strings.Contains(out, "proto=dot") || !strings.Contains(out, "TLS handshake = ") {
+		t.Errorf("success = %v, err %q, output %q", r.success, r.err, out)
+	}
+
+	// 不信任服务端证书时报告验证失败的原因
+	r, _, _ = dnsProbe(t, port, &Options{DNSTransport: dnsDoT})
+	if r.success || r.err == "" {
+		t.Errorf("untrusted certificate: success = %v, err %q", r.success, r.err)
+	}
+}
+
+func TestDNSPingOnceTimeout(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+	_, port, _ := net.SplitHostPort(pc.LocalAddr().String())
+	r, stats, _ := dnsProbe(t, port, &Options{DNSTransport: dnsUDP, Timeout: 200})
+	if r.success || !strings.Contains(r.err, "timeout") {
+		t.Errorf("success = %v, err %q; want timeout", r.success, r.err)
+	}
+	if sent, responded, _, _, _ := stats.getStats(); sent != 1 || responded != 0 {
+		t.Errorf("sent/responded = %d/%d", sent, responded)
+	}
+}
+
+func TestValidateDNSOptions(t *testing.T) {
+	if err := validateModes(&Options{DNS: true, DNSTransport: dnsDoT, CACert: "ca.pem", SNI: "dns.test"}); err != nil {
+		t.Errorf("TLS options with DoT rejected: %v", err)
+	}
+	if err := validateModes(&Options{DNS: true, DNSTransport: dnsUDP, CACert: "ca.pem"}); err == nil {
+		t.Error("--cacert without DoT should be rejected")
+	}
+	if err := validateModes(&Options{DNS: true, Proxy: "socks5://p"}); err == nil || !strings.Contains(err.Error(), "--dns") {
+		t.Errorf("--proxy with --dns: %v", err)
+	}
+	_, port, err := validateOptions(&Options{DNS: true, DNSTransport: dnsDoT}, []string{"1.1.1.1"})
+	if err != nil || port != "853" {
+		t.Errorf("DoT default port = %q, %v", port, err)
+	}
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// 标准健康检查服务的方法路径 (grpc/health/v1/health.proto)
+const grpcHealthPath = "/grpc.health.v1.Health/Check"
+
+// grpcServingStatus 是 HealthCheckResponse.ServingStatus 的取值
+var grpcServingStatus = map[uint64]string{
+	0: "UNKNOWN",
+	1: "SERVING",
+	2: "NOT_SERVING",
+	3: "SERVICE_UNKNOWN",
+}
+
+// grpcCodes 是 grpc-status 状态码的名称
+var grpcCodes = []string{
+	"OK", "CANCELLED", "UNKNOWN", "INVALID_ARGUMENT", "DEADLINE_EXCEEDED",
+	"NOT_FOUND", "ALREADY_EXISTS", "PERMISSION_DENIED", "RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION", "ABORTED", "OUT_OF_RANGE", "UNIMPLEMENTED",
+	"INTERNAL", "UNAVAILABLE", "DATA_LOSS", "UNAUTHENTICATED",
+}
+
+// grpcTargetURI 把 host:port（或 host port）转换为请求使用的地址。
+// 也接受 http:// 和 https:// 形式，未写协议时指定了 TLS 选项则使用 TLS，否则使用 h2c
+func grpcTargetURI(args []string, opts *Options) (string, error) {
+	lang := i18n.T()
+	if len(args) < 1 {
+		return "", errors.New(lang.ErrorGRPCRequiresTarget())
+	}
+	target := args[0]
+	if strings.Contains(target, "://") {
+		u, err := url.Parse(target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Port() == "" {
+			return "", fmt.Errorf(lang.ErrorGRPCTarget(), target)
+		}
+		return u.Scheme + "://" + u.Host, nil
+	}
+	if len(args) > 1 {
+		target = net.JoinHostPort(target, args[1])
+	}
+	host, port, err := net.SplitHostPort(target)
+	if err != nil || host == "" {
+		return "", fmt.Errorf(lang.ErrorGRPCTarget(), target)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return "", fmt.Errorf(lang.ErrorGRPCTarget(), target)
+	}
+	scheme := "http"
+	if grpcUseTLS(opts) {
+		scheme = "https"
+	}
+	return scheme + "://" + net.JoinHostPort(host, port), nil
+}
+
+// grpcUseTLS 报告是否指定了任何 TLS 相关选项
+func grpcUseTLS(opts *Options) bool {
+	return opts.InsecureSSL || opts.CACert != "" || opts.Cert != "" || opts.SNI != "" ||
+		opts.TLSMin != 0 || opts.TLSMax != 0 || opts.Ciphers != nil
+}
+
+// grpcFrame 按 gRPC 长度前缀格式封装一条未压缩的消息
+func grpcFrame(msg []byte) []byte {
+	frame := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	return append(frame, msg...)
+}
+
+// encodeHealthRequest 编码 HealthCheckRequest{service}，服务名为空时检查整个服务器
+func encodeHealthRequest(service string) []byte {
+	if service == "" {
+		return nil
+	}
+	msg := []byte{0x0a} // 字段 1，长度分隔类型
+	msg = binary.AppendUvarint(msg, uint64(len(service)))
+	return append(msg, service...)
+}
+
+// decodeHealthResponse 从响应体中解析 HealthCheckResponse 的 status 字段
+func decodeHealthResponse(body []byte) (uint64, error) {
+	invalid := errors.New(i18n.T().ErrorGRPCResponse())
+	if len(body) < 5 || body[0] != 0 {
+		// 没有消息或消息被压缩（请求未声明支持压缩）
+		return 0, invalid
+	}
+	n := binary.BigEndian.Uint32(body[1:5])
+	if uint64(len(body)-5) < uint64(n) {
+		return 0, invalid
+	}
+	msg := body[5 : 5+n]
+	var status uint64
+	for len(msg) > 0 {
+		key, k := binary.Uvarint(msg)
+		if k <= 0 {
+			return 0, invalid
+		}
+		msg = msg[k:]
+		switch key & 7 {
+		case 0:
+			v, k := binary.Uvarint(msg)
+			if k <= 0 {
+				return 0, invalid
+			}
+			if key>>3 == 1 {
+				status = v
+			}
+			msg = msg[k:]
+		case 1, 5:
+			size := 8
+			if key&7 == 5 {
+				size = 4
+			}
+			if len(msg) < size {
+				return 0, invalid
+			}
+			msg = msg[size:]
+		case 2:
+			l, k := binary.Uvarint(msg)
+			if k <= 0 || uint64(len(msg)-k) < l {
+				return 0, invalid
+			}
+			msg = msg[k+int(l):]
+		default:
+			return 0, invalid
+		}
+	}
+	return status, nil
+}
+
+// grpcStatusError 根据 grpc-status 和 grpc-message 构造错误，状态为 OK 时返回 nil
+func grpcStatusError(h http.Header) error {
+	raw := h.Get("Grpc-Status")
+	if raw == "" || raw == "0" {
+		return nil
+	}
+	name := raw
+	if code, err := strconv.Atoi(raw); err == nil && code >= 0 && code < len(grpcCodes) {
+		name = grpcCodes[code]
+	}
+	message := h.Get("Grpc-Message")
+	if m, err := url.PathUnescape(message); err == nil {
+		message = m
+	}
+	return fmt.Errorf(i18n.T().ErrorGRPCStatus(), raw, name, message)
+}
+
+// grpcPingOnce 调用一次 grpc.health.v1.Health/Check，服务状态为 SERVING 时视为成功
+func grpcPingOnce(ctx context.Context, probeClient *httpProbeClient, uri, service string, stats *Statistics, seq int, opts *Options) probeResult {
+	lang := i18n.T()
+	result := probeResult{seq: seq, time: time.Now()}
+	timeout := probeTimeout(ctx, opts)
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	trace := &connTrace{}
+	reqCtx = withProxyTrace(httptrace.WithClientTrace(reqCtx, trace.clientTrace()), trace)
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, uri+grpcHealthPath,
+		bytes.NewReader(grpcFrame(encodeHealthRequest(service))))
+	if err != nil {
+		return grpcFailed(ctx, stats, result, uri, 0, err, opts)
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("Te", "trailers")
+	req.Header.Set("Grpc-Timeout", strconv.FormatInt(timeout.Milliseconds(), 10)+"m")
+	req.Header.Set("User-Agent", "tcping/"+version+"."+gitHash)
+
+	start := time.Now()
+	resp, err := probeClient.client.Do(req)
+	if err != nil {
+		elapsed := durationMillis(time.Since(start))
+		if reason := describeTLSError(err); reason != "" {
+			err = errors.New(reason)
+		}
+		return grpcFailed(ctx, stats, result, uri, elapsed, err, opts)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	// 往返时间包含读取响应消息和 trailer
+	elapsed := durationMillis(time.Since(start))
+	if err == nil && resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf(lang.ErrorGRPCHTTPStatus(), resp.Status)
+	}
+	if err == nil {
+		// 出错时服务端可能只返回响应头（Trailers-Only）
+		if err = grpcStatusError(resp.Trailer); err == nil {
+			err = grpcStatusError(resp.Header)
+		}
+	}
+	if err != nil {
+		return grpcFailed(ctx, stats, result, uri, elapsed, err, opts)
+	}
+	code, err := decodeHealthResponse(body)
+	if err != nil {
+		return grpcFailed(ctx, stats, result, uri, elapsed, err, opts)
+	}
+	status, ok := grpcServingStatus[code]
+	if !ok {
+		status = strconv.FormatUint(code, 10)
+	}
+
+	serving := status == "SERVING"
+	stats.update(elapsed, serving)
+	stats.updateGRPCStatus(status)
+	if !trace.reused && trace.proxied {
+		stats.updateProxy(trace.proxy)
+	}
+	result.rtt = elapsed
+	result.success = serving
+	if !serving {
+		result.err = "status=" + status
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "gRPC %s: seq=%d status=%s proto=%s time=%.2fms conn=", uri, seq, status, resp.Proto, elapsed)
+	if trace.reused {
+		msg.WriteString("reused")
+	} else {
+		msg.WriteString("new")
+		if trace.proxied {
+			fmt.Fprintf(&msg, " proxy=%.2fms tunnel=%.2fms",
+				durationMillis(trace.proxy.connect), durationMillis(trace.proxy.tunnel))
+		}
+	}
+	msg.WriteByte('\n')
+	if serving {
+		fmt.Fprint(output, successText(msg.String(), opts.ColorOutput))
+	} else {
+		fmt.Fprint(output, errorText(msg.String(), opts.ColorOutput))
+	}
+
+	if opts.VerboseMode {
+		if trace.reused {
+			fmt.Fprintf(output, lang.MsgVerboseHTTPConnReused(), trace.localPort, trace.idle.Round(time.Millisecond))
+		} else {
+			fmt.Fprintf(output, lang.MsgVerboseHTTPConnNew(), trace.localPort, trace.connectMillis(), trace.tlsMillis())
+			if trace.proxied {
+				fmt.Fprintf(output, lang.MsgVerboseHTTPProxy(), opts.proxy,
+					durationMillis(trace.proxy.connect), durationMillis(trace.proxy.tunnel))
+			}
+		}
+		if resp.TLS != nil {
+			fmt.Fprintf(output, lang.MsgVerboseHTTPTLS(), tls.VersionName(resp.TLS.Version),
+				tls.CipherSuiteName(resp.TLS.CipherSuite), resp.TLS.ServerName)
+		}
+	}
+	return result
+}
+
+// grpcFailed 输出并记录一次未得到健康检查结果的探测
+func grpcFailed(ctx context.Context, stats *Statistics, result probeResult, uri string, elapsed float64, err error, opts *Options) probeResult {
+	lang := i18n.T()
+	if errors.Is(ctx.Err(), context.Canceled) {
+		fmt.Fprint(output, infoText(lang.MsgOperationCanceled(), opts.ColorOutput))
+		result.canceled = true
+		return result
+	}
+	stats.update(elapsed, false)
+	fmt.Fprint(output, errorText(fmt.Sprintf(lang.MsgGRPCFailed(), uri, result.seq, err), opts.ColorOutput))
+	result.rtt = elapsed
+	result.err = err.Error()
+	return result
+}
+
+// updateGRPCStatus 记录一次健康检查返回的服务状态
+func (s *Statistics) updateGRPCStatus(status string) {
+	s.Lock()
+	defer s.Unlock()
+	if s.grpcStatuses == nil {
+		s.grpcStatuses = make(map[string]int64)
+	}
+	s.grpcStatuses[status]++
+}
+
+// getGRPCStatuses 返回各服务状态出现次数的副本
+func (s *Statistics) getGRPCStatuses() map[string]int64 {
+	s.RLock()
+	defer s.RUnlock()
+	statuses := make(map[string]int64, len(s.grpcStatuses))
+	for k, v := range s.grpcStatuses {
+		statuses[k] = v
+	}
+	return statuses
+}
+
+// printGRPCStatistics 输出往返时间统计和各服务状态的次数
+func printGRPCStatistics(stats *Statistics) {
+	lang := i18n.T()
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Print(lang.MsgGRPCStatisticsTitle())
+	if sent == 0 {
+		return
+	}
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Printf(lang.MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+	statuses := stats.getGRPCStatuses()
+	names := make([]string, 0, len(statuses))
+	for name := range statuses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf(lang.MsgStatisticsGRPCStatus(), name, statuses[name])
+	}
+	printProxyStatistics(stats)
+}
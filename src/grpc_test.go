@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// newHealthServer 启动一个最小的 gRPC 健康检查服务端，statuses 为各服务名的 ServingStatus，
+// 未列出的服务返回 NOT_FOUND。tls 为 false 时使用 h2c
+func newHealthServer(t *testing.T, statuses map[string]uint64, tls bool) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != grpcHealthPath || r.Header.Get("Content-Type") != "application/grpc" {
+			http.NotFound(w, r)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		service := ""
+		if len(body) > 7 {
+			service = string(body[7:]) // 帧头、字段标签和长度之后是服务名
+		}
+		w.Header().Set("Content-Type", "application/grpc")
+		status, ok := statuses[service]
+		if !ok {
+			// Trailers-Only 响应，状态在响应头中
+			w.Header().Set("Grpc-Status", "5")
+			w.Header().Set("Grpc-Message", "unknown%20service")
+			return
+		}
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.Write(grpcFrame([]byte{0x08, byte(status)}))
+		w.Header().Set("Grpc-Status", "0")
+	}))
+	if tls {
+		srv.EnableHTTP2 = true
+		srv.StartTLS()
+	} else {
+		srv.Config.Protocols = new(http.Protocols)
+		srv.Config.Protocols.SetHTTP1(true)
+		srv.Config.Protocols.SetUnencryptedHTTP2(true)
+		srv.Start()
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// grpcProbe 执行一次健康检查，返回结果、统计和输出
+func grpcProbe(t *testing.T, uri string, opts *Options) (probeResult, *Statistics, string) {
+	t.Helper()
+	if opts.Timeout == 0 {
+		opts.Timeout = 2000
+	}
+	if err := loadTLSConfig(opts); err != nil {
+		t.Fatal(err)
+	}
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	opts.GRPC = true
+	r := grpcPingOnce(context.Background(), newHTTPProbeClient(opts), uri, opts.GRPCService, stats, 0, opts)
+	return r, stats, buf.String()
+}
+
+func TestDecodeHealthResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+		want uint64
+		ok   bool
+	}{
+		{"serving", grpcFrame([]byte{0x08, 0x01}), 1, true},
+		{"default value omitted", grpcFrame(nil), 0, true},
+		{"unknown fields skipped", grpcFrame([]byte{0x12, 0x02, 'h', 'i', 0x08, 0x02, 0x1d, 0, 0, 0, 0}), 2, true},
+		{"compressed", append([]byte{1}, grpcFrame([]byte{0x08, 0x01})[1:]...), 0, false},
+		{"truncated", grpcFrame([]byte{0x08, 0x01})[:6], 0, false},
+		{"empty", nil, 0, false},
+	}
+	for _, tt := range tests {
+		got, err := decodeHealthResponse(tt.body)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("%s: decodeHealthResponse = %d, %v", tt.name, got, err)
+		}
+	}
+}
+
+func TestEncodeHealthRequest(t *testing.T) {
+	if got := encodeHealthRequest(""); len(got) != 0 {
+		t.Errorf("empty service encoded as %v", got)
+	}
+	if got := string(encodeHealthRequest("api.v1.Users")); got != "\x0a\x0capi.v1.Users" {
+		t.Errorf("encodeHealthRequest = %q", got)
+	}
+}
+
+func TestGRPCTargetURI(t *testing.T) {
+	tests := []struct {
+		args []string
+		opts Options
+		want string
+	}{
+		{[]string{"localhost:50051"}, Options{}, "http://localhost:50051"},
+		{[]string{"localhost", "50051"}, Options{}, "http://localhost:50051"},
+		{[]string{"[::1]:443"}, Options{InsecureSSL: true}, "https://[::1]:443"},
+		{[]string{"https://svc.internal:8443"}, Options{}, "https://svc.internal:8443"},
+	}
+	for _, tt := range tests {
+		got, err := grpcTargetURI(tt.args, &tt.opts)
+		if err != nil || got != tt.want {
+			t.Errorf("grpcTargetURI(%v) = %q, %v; want %q", tt.args, got, err, tt.want)
+		}
+	}
+	for _, bad := range [][]string{nil, {"localhost"}, {"localhost:0"}, {"ftp://host:21"}, {"http://host"}} {
+		if _, err := grpcTargetURI(bad, &Options{}); err == nil {
+			t.Errorf("grpcTargetURI(%v) should fail", bad)
+		}
+	}
+}
+
+func TestGRPCPingOnce(t *testing.T) {
+	srv := newHealthServer(t, map[string]uint64{"": 1, "billing": 2}, false)
+
+	r, stats, out := grpcProbe(t, srv.URL, &Options{VerboseMode: true})
+	if !r.success || !strings.Contains(out, "status=SERVING proto=HTTP/2.0") {
+		t.Errorf("server check: success = %v, output %q", r.success, out)
+	}
+	if got := stats.getGRPCStatuses(); got["SERVING"] != 1 {
+		t.Errorf("statuses = %v", got)
+	}
+
+	r, stats, out = grpcProbe(t, srv.URL, &Options{GRPCService: "billing"})
+	if r.success || !strings.Contains(out, "status=NOT_SERVING") {
+		t.Errorf("NOT_SERVING: success = %v, output %q", r.success, out)
+	}
+	if got := stats.getGRPCStatuses(); got["NOT_SERVING"] != 1 {
+		t.Errorf("statuses = %v", got)
+	}
+
+	r, _, _ = grpcProbe(t, srv.URL, &Options{GRPCService: "missing"})
+	if r.success || !strings.Contains(r.err, "NOT_FOUND") || !strings.Contains(r.err, "unknown service") {
+		t.Errorf("unknown service: success = %v, err %q", r.success, r.err)
+	}
+}
+
+func TestGRPCPingOnceTLS(t *testing.T) {
+	srv := newHealthServer(t, map[string]uint64{"": 1}, true)
+	uri, err := grpcTargetURI([]string{strings.TrimPrefix(srv.URL, "https://")}, &Options{CACert: "set"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, out := grpcProbe(t, uri, &Options{CACert: serverCAFile(t, srv)})
+	if !r.success || !strings.Contains(out, "proto=HTTP/2.0") {
+		t.Errorf("TLS check: success = %v, err %q, output %q", r.success, r.err, out)
+	}
+}
+
+func TestGRPCPingOnceNotGRPC(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	r, _, _ := grpcProbe(t, srv.URL, &Options{})
+	if r.success || r.err == "" {
+		t.Errorf("probe of a non-gRPC server should fail, got %+v", r)
+	}
+}
+
+func TestValidateGRPCOptions(t *testing.T) {
+	if err := validateModes(&Options{GRPC: true, GRPCService: "api", CACert: "ca.pem", UnixSocket: "/run/app.sock"}); err != nil {
+		t.Errorf("valid --grpc options rejected: %v", err)
+	}
+	if err := validateModes(&Options{GRPCService: "api"}); err == nil || !strings.Contains(err.Error(), "--grpc") {
+		t.Errorf("--grpc-service without --grpc: %v", err)
+	}
+	for _, o := range []Options{
+		{GRPC: true, HTTPMode: true},
+		{GRPC: true, HTTP3: true},
+		{GRPC: true, WebSocket: true},
+	} {
+		if err := validateModes(&o); err == nil || !strings.Contains(err.Error(), "--grpc") {
+			t.Errorf("validateModes(%+v) = %v, want conflict with --grpc", o, err)
+		}
+	}
+}
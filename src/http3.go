@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// quicByteCounter 汇总 QUIC 连接上接收的字节数（不含 UDP 头部），
+// 已关闭连接的字节数累计到 closed 中
+type quicByteCounter struct {
+	mu     sync.Mutex
+	closed int64
+	conns  map[*quic.Conn]struct{}
+}
+
+func (c *quicByteCounter) track(conn *quic.Conn) {
+	c.mu.Lock()
+	if c.conns == nil {
+		c.conns = make(map[*quic.Conn]struct{})
+	}
+	c.conns[conn] = struct{}{}
+	c.mu.Unlock()
+
+	go func() {
+		<-conn.Context().Done()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.closed += int64(conn.ConnectionStats().BytesReceived)
+		delete(c.conns, conn)
+	}()
+}
+
+func (c *quicByteCounter) received() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := c.closed
+	for conn := range c.conns {
+		n += int64(conn.ConnectionStats().BytesReceived)
+	}
+	return n
+}
+
+// newHTTP3Transport 创建 HTTP/3 传输层。QUIC 的连接建立和 TLS 握手合并进行，
+// 因此 httptrace 中的连接耗时和 TLS 握手耗时都是整个 QUIC 握手的耗时
+func newHTTP3Transport(opts *Options, counter *quicByteCounter) *http3.Transport {
+	return &http3.Transport{
+		TLSClientConfig: probeTLSConfig(opts),
+		Dial: func(ctx context.Context, addr string, tlsCfg *tls.Config, cfg *quic.Config) (*quic.Conn, error) {
+			trace := httptrace.ContextClientTrace(ctx)
+			if trace != nil && trace.ConnectStart != nil {
+				trace.ConnectStart("udp", addr)
+			}
+			if trace != nil && trace.TLSHandshakeStart != nil {
+				trace.TLSHandshakeStart()
+			}
+			conn, err := quic.DialAddrEarly(ctx, addr, tlsCfg, cfg)
+			if err == nil {
+				// 等待握手完成，使耗时与 TCP+TLS 的连接阶段可比
+				select {
+				case <-conn.HandshakeComplete():
+				case <-ctx.Done():
+					conn.CloseWithError(0, "")
+					err = ctx.Err()
+				}
+			}
+			var state tls.ConnectionState
+			if err == nil {
+				state = conn.ConnectionState().TLS
+				counter.track(conn)
+			}
+			if trace != nil && trace.TLSHandshakeDone != nil {
+				trace.TLSHandshakeDone(state, err)
+			}
+			if trace != nil && trace.ConnectDone != nil {
+				trace.ConnectDone("udp", addr, err)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return conn, nil
+		},
+	}
+}
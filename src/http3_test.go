@@ -0,0 +1,42 @@
+package main
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/quic-go/quic-go/http3"
+)
+
+func TestHTTPPingOnceHTTP3(t *testing.T) {
+	t.Setenv("QUIC_GO_DISABLE_RECEIVE_BUFFER_WARNING", "true")
+
+	// 借用 httptest 生成的自签名证书
+	certSrv := httptest.NewTLSServer(http.NotFoundHandler())
+	certSrv.Close()
+
+	udp, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skipf("cannot listen on UDP: %v", err)
+	}
+	srv := &http3.Server{
+		TLSConfig: http3.ConfigureTLSConfig(certSrv.TLS),
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, strings.Repeat("h3", 1024))
+		}),
+	}
+	go srv.Serve(udp)
+	defer srv.Close()
+
+	uri := "https://" + udp.LocalAddr().String() + "/"
+	out := probeOutput(t, uri, &Options{InsecureSSL: true, HTTP3: true})
+	if !strings.Contains(out, "proto=HTTP/3.0 ") {
+		t.Errorf("output %q does not report HTTP/3", out)
+	}
+	if !strings.Contains(out, "body=2048 bytes") || strings.Contains(out, " size=0 ") {
+		t.Errorf("output %q should report body and QUIC wire bytes", out)
+	}
+}
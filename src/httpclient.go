@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+)
+
+// byteCounter 统计连接上实际收发的字节数，包括 TLS 记录层和 HTTP 头部
+type byteCounter struct {
+	read    int64 // 原子操作
+	written int64 // 原子操作
+}
+
+func (c *byteCounter) received() int64 {
+	return atomic.LoadInt64(&c.read)
+}
+
+// countingConn 包装底层 TCP 连接，为所属客户端和该连接分别累计线路字节数
+type countingConn struct {
+	net.Conn
+	counter *byteCounter // 所属客户端所有连接的累计
+	own     byteCounter  // 本连接的累计
+}
+
+func (c *countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	atomic.AddInt64(&c.counter.read, int64(n))
+	atomic.AddInt64(&c.own.read, int64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	atomic.AddInt64(&c.counter.written, int64(n))
+	atomic.AddInt64(&c.own.written, int64(n))
+	return n, err
+}
+
+// unwrapCountingConn 从 httptrace 报告的连接（TLS 时为 *tls.Conn）中取出 countingConn
+func unwrapCountingConn(conn net.Conn) *countingConn {
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		conn = tlsConn.NetConn()
+	}
+	c, _ := conn.(*countingConn)
+	return c
+}
+
+// httpProbeClient 是 HTTP 探测使用的客户端，每次运行只创建一个，
+// 连接是否复用由 --http-reuse/--http-fresh 决定而不是取决于客户端池。
+// 创建后不再修改，可以同时用于多次探测。HTTP/1.1 的连接同一时间只处理一个请求，
+// 单次探测的线路字节数由 connTrace 按所用连接的计数器计算；HTTP/2 和 HTTP/3
+// 的多个请求共用一个连接，探测逐次进行，按客户端计数器的差值计算
+type httpProbeClient struct {
+	client    *http.Client
+	transport *http.Transport // HTTP/1.1 和 HTTP/2 使用的传输层，--http3 时不使用
+	counter   *byteCounter
+	quic      *quicByteCounter // 仅 --http3
+}
+
+// received 返回该客户端所有连接在线路上接收的字节数
+func (c *httpProbeClient) received() int64 {
+	if c.quic != nil {
+		return c.quic.received()
+	}
+	return c.counter.received()
+}
+
+func newHTTPProbeClient(opts *Options) *httpProbeClient {
+	counter := &byteCounter{}
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			var conn net.Conn
+			var err error
+			if opts.UnixSocket != "" {
+				// 忽略 URI 中的主机和端口，始终连接指定的套接字
+				conn, err = dialer.DialContext(ctx, "unix", opts.UnixSocket)
+			} else if opts.proxy != nil {
+				var timing proxyTiming
+				conn, timing, err = opts.proxy.dial(ctx, dialer, addr)
+				if trace := proxyTraceFrom(ctx); trace != nil && err == nil {
+					trace.proxied = true
+					trace.proxy = timing
+				}
+			} else {
+				conn, err = dialer.DialContext(ctx, network, addr)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &countingConn{Conn: conn, counter: counter}, nil
+		},
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     30 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+		DisableCompression:  false,
+		// 新连接模式下每次探测都重新建立 TCP 和 TLS 连接
+		DisableKeepAlives: opts.HTTPFresh,
+		TLSClientConfig:   probeTLSConfig(opts),
+	}
+	if opts.Throughput {
+		// 大文件传输不限制总时长，超时只作用于等待响应头
+		transport.ResponseHeaderTimeout = time.Duration(opts.Timeout) * time.Millisecond
+		transport.MaxIdleConnsPerHost = opts.Streams
+	}
+	if opts.WebSocket {
+		// Upgrade 握手只能在 HTTP/1.1 上进行，不能通过 ALPN 协商到 h2
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetHTTP1(true)
+	}
+	if opts.HTTP2 || opts.GRPC {
+		// 只允许 HTTP/2：https 通过 ALPN 协商 h2，http 使用 h2c prior knowledge
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetHTTP2(true)
+		transport.Protocols.SetUnencryptedHTTP2(true)
+	}
+	probeClient := &httpProbeClient{
+		client: &http.Client{
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		transport: transport,
+		counter:   counter,
+	}
+	if opts.HTTP3 {
+		probeClient.quic = &quicByteCounter{}
+		probeClient.client.Transport = newHTTP3Transport(opts, probeClient.quic)
+	}
+	return probeClient
+}
+
+// goodputMbps 按响应体字节数和请求总耗时（毫秒）计算有效吞吐量。
+// 使用总耗时而不是单独的响应体传输时间，避免小响应体随头部一起到达时
+// 传输时间接近零导致数值失真
+func goodputMbps(bodyBytes int64, totalMs float64) float64 {
+	if totalMs <= 0 {
+		return 0
+	}
+	return float64(bodyBytes*8) / (totalMs * 1000)
+}
+
+// connTrace 通过 httptrace 记录单次请求所用连接的信息
+type connTrace struct {
+	reused       bool
+	idle         time.Duration // 复用前连接的空闲时间
+	localPort    int
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	proxied      bool        // 新连接经过代理建立
+	proxy        proxyTiming // 代理连接和隧道建立的耗时
+	conn         *countingConn
+	connBefore   int64 // 开始使用 conn 时该连接已接收的字节数，新连接为 0 以包含握手
+	wire         int64 // 跟随重定向时之前各跳在各自连接上接收的字节数
+}
+
+func (t *connTrace) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		ConnectStart:      func(string, string) { t.connectStart = time.Now() },
+		ConnectDone:       func(string, string, error) { t.connectDone = time.Now() },
+		TLSHandshakeStart: func() { t.tlsStart = time.Now() },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { t.tlsDone = time.Now() },
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.reused = info.Reused
+			t.idle = info.IdleTime
+			t.useConn(unwrapCountingConn(info.Conn), info.Reused)
+			if addr, ok := info.Conn.LocalAddr().(*net.TCPAddr); ok {
+				t.localPort = addr.Port
+			}
+		},
+	}
+}
+
+// useConn 切换到本次请求（或重定向的下一跳）使用的连接，累计上一个连接上接收的字节数
+func (t *connTrace) useConn(conn *countingConn, reused bool) {
+	t.wire = t.wireBytes()
+	t.conn, t.connBefore = conn, 0
+	if conn != nil && reused {
+		t.connBefore = conn.own.received()
+	}
+}
+
+// wireBytes 返回本次请求在所用连接上接收的字节数，包括新连接的握手
+func (t *connTrace) wireBytes() int64 {
+	if t.conn == nil {
+		return t.wire
+	}
+	return t.wire + t.conn.own.received() - t.connBefore
+}
+
+// connectMillis 返回建立 TCP 连接的耗时，复用连接时为 0
+func (t *connTrace) connectMillis() float64 {
+	if t.connectDone.IsZero() {
+		return 0
+	}
+	return durationMillis(t.connectDone.Sub(t.connectStart))
+}
+
+// tlsMillis 返回 TLS 握手耗时，复用连接或明文 HTTP 时为 0
+func (t *connTrace) tlsMillis() float64 {
+	if t.tlsDone.IsZero() {
+		return 0
+	}
+	return durationMillis(t.tlsDone.Sub(t.tlsStart))
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+func TestGoodputMbps(t *testing.T) {
+	if got := goodputMbps(125000, 1000); got != 1 {
+		t.Errorf("goodputMbps(125000, 1000) = %v, want 1", got)
+	}
+	if got := goodputMbps(1024, 0); got != 0 {
+		t.Errorf("goodputMbps with zero duration = %v, want 0", got)
+	}
+}
+
+func TestStatisticsUpdateHTTPTransfer(t *testing.T) {
+	var s Statistics
+	// 响应头 10ms 到达，响应体再传输 90ms
+	s.updateHTTPTransfer(10, 100, 5000, 125000, true)
+	_, _, totalBytes, minTime, _, _, minBW, _, _ := s.getHTTPStats()
+	if totalBytes != 5000 {
+		t.Errorf("totalBytes = %d, want wire bytes 5000", totalBytes)
+	}
+	if s.getBodyBytes() != 125000 {
+		t.Errorf("bodyBytes = %d, want 125000", s.getBodyBytes())
+	}
+	if minTime != 10 {
+		t.Errorf("minTime = %v, want time to headers 10", minTime)
+	}
+	if minBW != 10 {
+		t.Errorf("goodput = %v, want 10 Mbps over the total time", minBW)
+	}
+}
+
+// runHTTPProbe 对测试服务器执行一次 HTTP 探测并丢弃逐行输出
+func runHTTPProbe(t *testing.T, uri string) (*Statistics, probeResult) {
+	t.Helper()
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	r := httpPingOnce(context.Background(), newHTTPProbeClient(&Options{}), uri, 5000, stats, 0, &Options{})
+	if !r.success {
+		t.Fatalf("probe failed: %s", r.err)
+	}
+	return stats, r
+}
+
+func TestHTTPPingOnceCountsWireBytes(t *testing.T) {
+	body := strings.Repeat("x", 256*1024)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, body)
+	}))
+	defer srv.Close()
+
+	stats, _ := runHTTPProbe(t, srv.URL)
+	_, _, wire, _, _, _, _, _, _ := stats.getHTTPStats()
+	if got := stats.getBodyBytes(); got != int64(len(body)) {
+		t.Errorf("body bytes = %d, want %d", got, len(body))
+	}
+	// 线路字节数包括状态行、响应头和分块编码
+	if wire <= int64(len(body)) {
+		t.Errorf("wire bytes = %d, want more than body size %d", wire, len(body))
+	}
+}
+
+func TestHTTPPingOnceCompressedBody(t *testing.T) {
+	body := strings.Repeat("compressible ", 20000)
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write([]byte(body))
+	zw.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			t.Error("transport should request gzip encoding")
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(gz.Bytes())
+	}))
+	defer srv.Close()
+
+	stats, _ := runHTTPProbe(t, srv.URL)
+	_, _, wire, _, _, _, _, _, _ := stats.getHTTPStats()
+	if got := stats.getBodyBytes(); got != int64(len(body)) {
+		t.Errorf("body bytes = %d, want decompressed size %d", got, len(body))
+	}
+	if wire >= int64(len(body)) || wire < int64(gz.Len()) {
+		t.Errorf("wire bytes = %d, want between compressed %d and decompressed %d", wire, gz.Len(), len(body))
+	}
+}
+
+// probeConnections 连续探测 n 次，返回每次使用的本地端口和复用/新建连接统计
+func probeConnections(t *testing.T, opts *Options, n int) ([]int, latencyGroup, latencyGroup) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	client := newHTTPProbeClient(opts)
+	stats := &Statistics{}
+	var ports []int
+	for i := 0; i < n; i++ {
+		var port int
+		trace := &httptrace.ClientTrace{GotConn: func(info httptrace.GotConnInfo) {
+			port = info.Conn.LocalAddr().(*net.TCPAddr).Port
+		}}
+		ctx := httptrace.WithClientTrace(context.Background(), trace)
+		if r := httpPingOnce(ctx, client, srv.URL, 5000, stats, i, opts); !r.success {
+			t.Fatalf("probe %d failed: %s", i, r.err)
+		}
+		ports = append(ports, port)
+	}
+	reused, fresh := stats.getHTTPConnStats()
+	return ports, reused, fresh
+}
+
+func TestHTTPPingOnceReusesConnection(t *testing.T) {
+	ports, reused, fresh := probeConnections(t, &Options{HTTPReuse: true}, 3)
+	if fresh.count != 1 || reused.count != 2 {
+		t.Errorf("new = %d, reused = %d, want 1 and 2", fresh.count, reused.count)
+	}
+	if ports[0] != ports[1] || ports[1] != ports[2] {
+		t.Errorf("local ports = %v, want the same connection", ports)
+	}
+}
+
+func TestHTTPPingOnceFreshConnections(t *testing.T) {
+	ports, reused, fresh := probeConnections(t, &Options{HTTPFresh: true}, 3)
+	if fresh.count != 3 || reused.count != 0 {
+		t.Errorf("new = %d, reused = %d, want 3 and 0", fresh.count, reused.count)
+	}
+	if ports[0] == ports[1] || ports[1] == ports[2] {
+		t.Errorf("local ports = %v, want a new connection per probe", ports)
+	}
+}
+
+func TestValidateHTTPOptions(t *testing.T) {
+	tests := []struct {
+		opts    Options
+		wantErr bool
+	}{
+		{Options{HTTPMode: true, HTTPFresh: true}, false},
+		{Options{HTTPMode: true, HTTPReuse: true, HTTPFresh: true}, true},
+		{Options{HTTPFresh: true}, true},
+		{Options{Throughput: true}, true},
+		{Options{Follow: true}, true},
+		{Options{HTTPMode: true, MaxRedirs: -1}, true},
+		{Options{HTTPMode: true, TLSMin: tls.VersionTLS13, TLSMax: tls.VersionTLS12}, true},
+		{Options{CACert: "ca.pem"}, true},
+		{Options{}, false},
+	}
+	for _, tt := range tests {
+		if err := validateModes(&tt.opts); (err != nil) != tt.wantErr {
+			t.Errorf("validateModes(%+v) error = %v, wantErr %v", tt.opts, err, tt.wantErr)
+		}
+	}
+}
+
+// probeOutput 执行一次探测并返回逐行输出
+func probeOutput(t *testing.T, uri string, opts *Options) string {
+	t.Helper()
+	var buf bytes.Buffer
+	output = &buf
+	defer func() { output = os.Stdout }()
+	client := newHTTPProbeClient(opts)
+	defer client.client.CloseIdleConnections()
+	if r := httpPingOnce(context.Background(), client, uri, 5000, &Statistics{}, 0, opts); !r.success {
+		t.Fatalf("probe failed: %s", r.err)
+	}
+	return buf.String()
+}
+
+func TestHTTPPingOnceReportsProtocol(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.Proto)
+	})
+
+	tlsSrv := httptest.NewUnstartedServer(handler)
+	tlsSrv.EnableHTTP2 = true
+	tlsSrv.StartTLS()
+	defer tlsSrv.Close()
+
+	h2cSrv := httptest.NewUnstartedServer(handler)
+	h2cSrv.Config.Protocols = new(http.Protocols)
+	h2cSrv.Config.Protocols.SetHTTP1(true)
+	h2cSrv.Config.Protocols.SetUnencryptedHTTP2(true)
+	h2cSrv.Start()
+	defer h2cSrv.Close()
+
+	tests := []struct {
+		name string
+		uri  string
+		opts Options
+		want string
+	}{
+		{"default over TLS", tlsSrv.URL, Options{InsecureSSL: true}, "proto=HTTP/1.1 "},
+		{"forced h2 over TLS", tlsSrv.URL, Options{InsecureSSL: true, HTTP2: true}, "proto=HTTP/2.0 "},
+		{"h2c prior knowledge", h2cSrv.URL, Options{HTTP2: true}, "proto=HTTP/2.0 "},
+	}
+	for _, tt := range tests {
+		if out := probeOutput(t, tt.uri, &tt.opts); !strings.Contains(out, tt.want) {
+			t.Errorf("%s: output %q does not contain %q", tt.name, out, tt.want)
+		}
+	}
+}
+
+func TestHTTP2FailsWithoutServerSupport(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	// 服务端会记录ALPN协商失败，测试中不需要这条日志
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	opts := &Options{InsecureSSL: true, HTTP2: true}
+	if r := httpPingOnce(context.Background(), newHTTPProbeClient(opts), srv.URL, 5000, &Statistics{}, 0, opts); r.success {
+		t.Error("forced HTTP/2 should fail against an HTTP/1.1-only server")
+	}
+}
+
+func TestHTTPPingOnceOverlapping(t *testing.T) {
+	// 同一个客户端上同时进行多次探测，每次探测使用各自的超时
+	i18n.Initialize("en-US")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	opts := &Options{}
+	client := newHTTPProbeClient(opts)
+	defer client.client.CloseIdleConnections()
+	stats := &Statistics{}
+	results := make([]probeResult, 6)
+	var wg sync.WaitGroup
+	for seq := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			timeout := 2000
+			if seq%2 == 1 {
+				timeout = 30
+			}
+			results[seq] = httpPingOnce(context.Background(), client, srv.URL, timeout, stats, seq, opts)
+		}()
+	}
+	wg.Wait()
+	for seq, r := range results {
+		if want := seq%2 == 0; r.success != want {
+			t.Errorf("seq %d: success = %v, want %v (err %q)", seq, r.success, want, r.err)
+		}
+		if !r.success && errorClass(r.err) != "timeout" {
+			t.Errorf("seq %d: error %q should be classified as a timeout", seq, r.err)
+		}
+	}
+	if client.client.Timeout != 0 {
+		t.Errorf("client timeout = %v, probes should not modify the shared client", client.client.Timeout)
+	}
+}
+
+// lockedWriter 允许同时进行的探测并发写入 output
+type lockedWriter struct {
+	mu sync.Mutex
+	b  strings.Builder
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.b.Write(p)
+}
+
+func TestHTTPPingOnceOverlappingWireBytes(t *testing.T) {
+	// 同时进行的探测各自使用一个连接，线路字节数不包括其他探测的响应
+	i18n.Initialize("en-US")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(r.URL.Query().Get("n"))
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Length", strconv.Itoa(n))
+		io.WriteString(w, strings.Repeat("x", n))
+	}))
+	defer srv.Close()
+
+	out := &lockedWriter{}
+	output = out
+	defer func() { output = os.Stdout }()
+	opts := &Options{}
+	client := newHTTPProbeClient(opts)
+	defer client.client.CloseIdleConnections()
+	stats := &Statistics{}
+	// 第二轮复用第一轮建立的连接
+	for round := 0; round < 2; round++ {
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				uri := fmt.Sprintf("%s/?n=%d", srv.URL, (i+1)*50000)
+				if r := httpPingOnce(context.Background(), client, uri, 5000, stats, round*4+i, opts); !r.success {
+					t.Errorf("probe %d failed: %s", round*4+i, r.err)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	lines := regexp.MustCompile(`seq=(\d+) .*size=(\d+) bytes body=(\d+) bytes`).FindAllStringSubmatch(out.b.String(), -1)
+	if len(lines) != 8 {
+		t.Fatalf("result lines = %d, want 8:\n%s", len(lines), out.b.String())
+	}
+	var sum int64
+	for _, m := range lines {
+		seq, _ := strconv.Atoi(m[1])
+		size, _ := strconv.ParseInt(m[2], 10, 64)
+		body, _ := strconv.ParseInt(m[3], 10, 64)
+		sum += size
+		if want := int64(seq%4+1) * 50000; body != want {
+			t.Errorf("seq %d: body = %d, want %d", seq, body, want)
+		}
+		// 超出响应体的部分只有状态行和响应头
+		if size <= body || size > body+1024 {
+			t.Errorf("seq %d: size = %d for a %d byte body, other probes' bytes were counted", seq, size, body)
+		}
+	}
+	if _, _, wire, _, _, _, _, _, _ := stats.getHTTPStats(); wire != sum {
+		t.Errorf("total wire bytes = %d, want the sum of per-probe sizes %d", wire, sum)
+	}
+}
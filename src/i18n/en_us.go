@@ -299,11 +299,11 @@ func (e *EnglishLang) MsgStatisticsRTT() string {
 }
 
 func (e *EnglishLang) MsgStatisticsTotalData() string {
-	return "Total data transferred: %d bytes (%.2f MB)\n"
+	return "Total data received (on the wire): %d bytes (%.2f MB)\n"
 }
 
 func (e *EnglishLang) MsgStatisticsBandwidth() string {
-	return "Estimated bandwidth: Min = %.2f Mbps, Max = %.2f Mbps, Avg = %.2f Mbps\n"
+	return "Goodput (body / total time): Min = %.2f Mbps, Max = %.2f Mbps, Avg = %.2f Mbps\n"
 }
 
 // IP type strings
@@ -313,4 +313,1103 @@ func (e *EnglishLang) IPv4String() string {
 
 func (e *EnglishLang) IPv6String() string {
 	return "IPv6"
+}
+
+// Argument parsing
+func (e *EnglishLang) ErrorUnknownOption() string {
+	return "Unknown option: %s"
+}
+
+func (e *EnglishLang) ErrorOptionRequiresValue() string {
+	return "Option %s requires a value"
+}
+
+func (e *EnglishLang) ErrorInvalidOptionValue() string {
+	return "Invalid value for option %s: %s"
+}
+
+func (e *EnglishLang) ErrorOptionTakesNoValue() string {
+	return "Option %s does not take a value"
+}
+
+func (e *EnglishLang) ErrorTryHelp() string {
+	return "Try 'tcping -h' for more information"
+}
+
+// Live dashboard
+func (e *EnglishLang) OptTUI() string {
+	return "Full-screen live dashboard with RTT sparkline"
+}
+
+func (e *EnglishLang) MsgTUIUnsupported() string {
+	return "Terminal does not support the live dashboard, falling back to line output\n"
+}
+
+func (e *EnglishLang) MsgTUITitle() string {
+	return "%s live dashboard - %s"
+}
+
+func (e *EnglishLang) MsgTUIElapsed() string {
+	return "Elapsed %s, probes sent %d"
+}
+
+func (e *EnglishLang) MsgTUIRTT() string {
+	return "RTT"
+}
+
+func (e *EnglishLang) MsgTUILastRTT() string {
+	return "last %.2fms"
+}
+
+func (e *EnglishLang) MsgTUILoss() string {
+	return "Loss"
+}
+
+func (e *EnglishLang) MsgTUILossDetail() string {
+	return "%.1f%% (%d/%d lost)"
+}
+
+func (e *EnglishLang) MsgTUIStatsLabel() string {
+	return "Stats"
+}
+
+func (e *EnglishLang) MsgTUIStats() string {
+	return "min %.2fms  avg %.2fms  max %.2fms  p50 %.2fms  p90 %.2fms  p99 %.2fms"
+}
+
+func (e *EnglishLang) MsgTUILastError() string {
+	return "Last error"
+}
+
+func (e *EnglishLang) MsgTUINone() string {
+	return "none"
+}
+
+func (e *EnglishLang) MsgTUIOutages() string {
+	return "Outages"
+}
+
+func (e *EnglishLang) MsgTUIOutageEntry() string {
+	return "%s - %s  %d failed probes  %s"
+}
+
+func (e *EnglishLang) MsgTUIOutageOngoing() string {
+	return "ongoing"
+}
+
+func (e *EnglishLang) MsgTUIHint() string {
+	return "Press Ctrl-C to stop"
+}
+
+// Interim statistics
+func (e *EnglishLang) OptSummaryEvery() string {
+	return "Print interim statistics periodically (e.g. 60s); SIGQUIT/SIGUSR1 prints them on demand"
+}
+
+func (e *EnglishLang) OptSummaryWindow() string {
+	return "Include statistics for the last interval in interim summaries"
+}
+
+func (e *EnglishLang) MsgInterimTitle() string {
+	return "\n--- interim statistics at %s (running %s) ---\n"
+}
+
+func (e *EnglishLang) MsgInterimWindow() string {
+	return "Last %s:\n"
+}
+
+// Stop conditions
+func (e *EnglishLang) OptDuration() string {
+	return "Stop after running for this long (e.g. 10m)"
+}
+
+func (e *EnglishLang) OptUntilSuccess() string {
+	return "Stop at the first successful probe (wait until the port is open)"
+}
+
+func (e *EnglishLang) OptUntilFailure() string {
+	return "Stop at the first failed probe"
+}
+
+func (e *EnglishLang) OptMaxFailures() string {
+	return "Stop after this many failed probes in total"
+}
+
+func (e *EnglishLang) MsgStopDuration() string {
+	return "\nStopped: run duration of %s reached\n"
+}
+
+func (e *EnglishLang) MsgStopUntilSuccess() string {
+	return "\nStopped: target responded (--until-success)\n"
+}
+
+func (e *EnglishLang) MsgStopUntilFailure() string {
+	return "\nStopped: probe failed (--until-failure)\n"
+}
+
+func (e *EnglishLang) MsgStopMaxFailures() string {
+	return "\nStopped: %d failures reached (--max-failures)\n"
+}
+
+// Thresholds and verdict
+func (e *EnglishLang) OptMaxLoss() string {
+	return "Fail if packet loss exceeds this percentage (e.g. 5%)"
+}
+
+func (e *EnglishLang) OptMaxAvgRTT() string {
+	return "Fail if the average RTT exceeds this duration (e.g. 50ms)"
+}
+
+func (e *EnglishLang) OptMaxP99RTT() string {
+	return "Fail if the p99 RTT exceeds this duration"
+}
+
+func (e *EnglishLang) OptJSON() string {
+	return "Print the final statistics and verdict as JSON"
+}
+
+func (e *EnglishLang) MsgThresholdLoss() string {
+	return "loss %.1f%% exceeds %.1f%%"
+}
+
+func (e *EnglishLang) MsgThresholdAvgRTT() string {
+	return "average RTT %.2fms exceeds %.2fms"
+}
+
+func (e *EnglishLang) MsgThresholdP99RTT() string {
+	return "p99 RTT %.2fms exceeds %.2fms"
+}
+
+func (e *EnglishLang) MsgThresholdNoResponse() string {
+	return "no successful responses"
+}
+
+func (e *EnglishLang) MsgVerdictPass() string {
+	return "Verdict: PASS\n"
+}
+
+func (e *EnglishLang) MsgVerdictFail() string {
+	return "Verdict: FAIL (%s)\n"
+}
+
+// HTTP transfer
+func (e *EnglishLang) MsgStatisticsBodyData() string {
+	return "Response body (decompressed): %d bytes (%.2f MB)\n"
+}
+
+func (e *EnglishLang) MsgVerboseHTTPTransfer() string {
+	return "    Timing: headers = %.2fms, body = %.2fms, total = %.2fms\n"
+}
+
+// Throughput test
+func (e *EnglishLang) OptThroughput() string {
+	return "Measure sustained HTTP throughput instead of sending health-check requests"
+}
+
+func (e *EnglishLang) OptUploadSize() string {
+	return "Upload a generated payload of this size (e.g. 10M) instead of downloading"
+}
+
+func (e *EnglishLang) OptStreams() string {
+	return "Number of parallel throughput streams (default 1)"
+}
+
+func (e *EnglishLang) MsgThroughputDownload() string {
+	return "download"
+}
+
+func (e *EnglishLang) MsgThroughputUpload() string {
+	return "upload"
+}
+
+func (e *EnglishLang) MsgThroughputStart() string {
+	return "Throughput test (%s, %d streams), sampling every %s\n"
+}
+
+func (e *EnglishLang) MsgThroughputSample() string {
+	return "  %7.2fs  %10.2f Mbps  (%d bytes)\n"
+}
+
+func (e *EnglishLang) MsgThroughputStreamFailed() string {
+	return "  stream %d failed: %v\n"
+}
+
+func (e *EnglishLang) MsgThroughputStreamDone() string {
+	return "  stream %d: %d bytes in %s\n"
+}
+
+func (e *EnglishLang) MsgThroughputStatisticsTitle() string {
+	return "\n--- HTTP throughput statistics ---\n"
+}
+
+func (e *EnglishLang) MsgThroughputStreams() string {
+	return "Streams = %d, Completed = %d, Failed = %d\n"
+}
+
+func (e *EnglishLang) MsgThroughputTransferred() string {
+	return "Transferred (%s): %d bytes (%.2f MB) in %s\n"
+}
+
+func (e *EnglishLang) MsgThroughputRampUp() string {
+	return "Ramp-up time: %s\n"
+}
+
+func (e *EnglishLang) MsgThroughputSustained() string {
+	return "Sustained throughput: Min = %.2f Mbps, Max = %.2f Mbps, Avg = %.2f Mbps\n"
+}
+
+func (e *EnglishLang) MsgThroughputFinal() string {
+	return "Final throughput: %.2f Mbps\n"
+}
+
+func (e *EnglishLang) MsgThroughputTTFB() string {
+	return "Time to first byte: Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+// HTTP connection reuse
+func (e *EnglishLang) OptHTTPReuse() string {
+	return "Reuse keep-alive connections between HTTP probes (default)"
+}
+
+func (e *EnglishLang) OptHTTPFresh() string {
+	return "Open a new TCP/TLS connection for every HTTP probe"
+}
+
+func (e *EnglishLang) ErrorRequiresHTTPMode() string {
+	return "%s requires HTTP mode (-H)"
+}
+
+func (e *EnglishLang) ErrorHTTPReuseFresh() string {
+	return "--http-reuse and --http-fresh cannot be used together"
+}
+
+func (e *EnglishLang) MsgVerboseHTTPConnReused() string {
+	return "    Connection: reused, local port %d, idle for %s\n"
+}
+
+func (e *EnglishLang) MsgVerboseHTTPConnNew() string {
+	return "    Connection: new, local port %d, connect = %.2fms, TLS handshake = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgStatisticsReusedConns() string {
+	return "Reused connections: %d, Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgStatisticsNewConns() string {
+	return "New connections: %d, Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+// HTTP protocol version
+func (e *EnglishLang) OptHTTP2() string {
+	return "Force HTTP/2 (h2 via ALPN for https, h2c prior knowledge for http)"
+}
+
+func (e *EnglishLang) OptHTTP3() string {
+	return "Use HTTP/3 over QUIC (https only)"
+}
+
+func (e *EnglishLang) ErrorHTTP2HTTP3() string {
+	return "--http2 and --http3 cannot be used together"
+}
+
+func (e *EnglishLang) ErrorHTTP3RequiresHTTPS() string {
+	return "--http3 requires an https:// URI"
+}
+
+// HTTP redirects
+func (e *EnglishLang) OptFollow() string {
+	return "Follow HTTP redirects and report the final response"
+}
+
+func (e *EnglishLang) OptMaxRedirs() string {
+	return "Maximum number of redirects to follow (default 10)"
+}
+
+func (e *EnglishLang) ErrorRedirectDowngrade() string {
+	return "redirect downgrades from https to http: %v -> %v"
+}
+
+func (e *EnglishLang) ErrorRedirectLoop() string {
+	return "redirect loop detected at %v"
+}
+
+func (e *EnglishLang) ErrorTooManyRedirects() string {
+	return "stopped after %d redirects"
+}
+
+func (e *EnglishLang) MsgVerboseHTTPRedirect() string {
+	return "    Redirect %d: %d %s -> %s (%.2fms)\n"
+}
+
+// TLS options
+func (e *EnglishLang) OptCACert() string {
+	return "Trust the CA certificates in this PEM file instead of the system roots"
+}
+
+func (e *EnglishLang) OptCert() string {
+	return "Client certificate (PEM) for mutual TLS"
+}
+
+func (e *EnglishLang) OptKey() string {
+	return "Private key (PEM) for --cert (default: read from the --cert file)"
+}
+
+func (e *EnglishLang) OptTLSMin() string {
+	return "Minimum TLS version (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (e *EnglishLang) OptTLSMax() string {
+	return "Maximum TLS version (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (e *EnglishLang) OptCiphers() string {
+	return "Comma-separated cipher suites allowed for TLS 1.2 and below"
+}
+
+func (e *EnglishLang) OptSNI() string {
+	return "Server name sent in the TLS handshake and used to verify the certificate"
+}
+
+func (e *EnglishLang) ErrorTLSVersionRange() string {
+	return "--tls-min must not be higher than --tls-max"
+}
+
+func (e *EnglishLang) ErrorTLSLoadCACert() string {
+	return "cannot read CA certificate file %s: %w"
+}
+
+func (e *EnglishLang) ErrorTLSNoCACerts() string {
+	return "no PEM certificates found in %s"
+}
+
+func (e *EnglishLang) ErrorTLSKeyWithoutCert() string {
+	return "--key requires --cert"
+}
+
+func (e *EnglishLang) ErrorTLSLoadClientCert() string {
+	return "cannot load client certificate: %w"
+}
+
+func (e *EnglishLang) ErrorTLSUnknownAuthority() string {
+	return "certificate signed by unknown authority (issuer: %s), use --cacert to trust it"
+}
+
+func (e *EnglishLang) ErrorTLSHostname() string {
+	return "certificate is not valid for %s (valid for: %s)"
+}
+
+func (e *EnglishLang) ErrorTLSExpired() string {
+	return "certificate has expired or is not yet valid (valid from %s to %s)"
+}
+
+func (e *EnglishLang) ErrorTLSInvalidCert() string {
+	return "certificate is invalid: %v"
+}
+
+func (e *EnglishLang) ErrorTLSNotTLS() string {
+	return "server did not respond with TLS (is this an http:// port?)"
+}
+
+func (e *EnglishLang) ErrorTLSRemoteAlert() string {
+	return "server rejected the TLS handshake: %v"
+}
+
+func (e *EnglishLang) MsgVerboseHTTPTLS() string {
+	return "    TLS: %s, %s, server name %s\n"
+}
+
+// Proxy
+func (e *EnglishLang) OptProxy() string {
+	return "Connect through an HTTP CONNECT or SOCKS5 proxy (http://, socks5://, socks5h://, user:pass@ supported)"
+}
+
+func (e *EnglishLang) OptProxyEnv() string {
+	return "Use the proxy from HTTP_PROXY/HTTPS_PROXY, honoring NO_PROXY"
+}
+
+func (e *EnglishLang) ErrorProxyURL() string {
+	return "invalid proxy address: %s"
+}
+
+func (e *EnglishLang) ErrorProxyScheme() string {
+	return "unsupported proxy scheme %q (use http, socks5 or socks5h)"
+}
+
+func (e *EnglishLang) ErrorProxyHTTP3() string {
+	return "--http3 cannot be used through a proxy"
+}
+
+func (e *EnglishLang) ErrorProxyDial() string {
+	return "cannot connect to proxy %v: %v"
+}
+
+func (e *EnglishLang) ErrorProxyConnect() string {
+	return "proxy refused CONNECT: %s"
+}
+
+func (e *EnglishLang) ErrorSOCKSProtocol() string {
+	return "invalid SOCKS5 response from proxy"
+}
+
+func (e *EnglishLang) ErrorSOCKSNoMethod() string {
+	return "SOCKS5 proxy requires an unsupported authentication method"
+}
+
+func (e *EnglishLang) ErrorSOCKSAuth() string {
+	return "SOCKS5 proxy authentication failed"
+}
+
+func (e *EnglishLang) ErrorSOCKSReply() string {
+	return "SOCKS5 proxy could not connect to the target: %s"
+}
+
+func (e *EnglishLang) MsgViaProxy() string {
+	return "Using proxy %s\n"
+}
+
+func (e *EnglishLang) MsgTCPPingStartProxy() string {
+	return "TCP ping to %s port %s (address resolved by the proxy)\n"
+}
+
+func (e *EnglishLang) MsgVerboseHTTPProxy() string {
+	return "    Proxy: %s, proxy connect = %.2fms, tunnel = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgStatisticsProxyConnect() string {
+	return "Proxy connect: %d, Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgStatisticsProxyTunnel() string {
+	return "Tunnel to target: %d, Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+// Unix domain sockets
+func (e *EnglishLang) OptUnixSocket() string {
+	return "Send HTTP probes over this Unix domain socket (the URI only supplies path and Host)"
+}
+
+func (e *EnglishLang) ErrorUnixSocketConflict() string {
+	return "Unix domain sockets cannot be used with --proxy or --http3"
+}
+
+func (e *EnglishLang) ErrorUnixTargetPort() string {
+	return "unix: targets do not take a port"
+}
+
+func (e *EnglishLang) MsgUnixPingStart() string {
+	return "Connecting to Unix domain socket %s\n"
+}
+
+func (e *EnglishLang) MsgViaUnixSocket() string {
+	return "Using Unix domain socket %s\n"
+}
+
+// WebSocket
+func (e *EnglishLang) OptWebSocket() string {
+	return "WebSocket mode: handshake with a ws:// or wss:// URI, then measure ping/pong round trips"
+}
+
+func (e *EnglishLang) ErrorNotWithWebSocket() string {
+	return "%s cannot be used with --ws"
+}
+
+func (e *EnglishLang) ErrorWSRequiresURI() string {
+	return "WebSocket mode requires a URI\n\nUsage: tcping --ws [options] <ws://...|wss://...>\nTry 'tcping -h' for more information"
+}
+
+func (e *EnglishLang) ErrorWSURI() string {
+	return "WebSocket URI must start with ws:// or wss://: %s"
+}
+
+func (e *EnglishLang) ErrorWSHandshakeStatus() string {
+	return "WebSocket handshake rejected: %s"
+}
+
+func (e *EnglishLang) ErrorWSHandshakeInvalid() string {
+	return "invalid WebSocket handshake response"
+}
+
+func (e *EnglishLang) ErrorWSProtocol() string {
+	return "WebSocket protocol error: oversized control frame"
+}
+
+func (e *EnglishLang) ErrorWSClosed() string {
+	return "connection closed by server (code %d %s)"
+}
+
+func (e *EnglishLang) ErrorWSPongTimeout() string {
+	return "no pong within %dms"
+}
+
+func (e *EnglishLang) MsgWSPingStart() string {
+	return "WebSocket ping to %s\n"
+}
+
+func (e *EnglishLang) MsgWSFailed() string {
+	return "WebSocket %s: seq=%d failed: %v\n"
+}
+
+func (e *EnglishLang) MsgVerboseWSHandshake() string {
+	return "  Handshake: total = %.2fms, connect = %.2fms, TLS handshake = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgWSStatisticsTitle() string {
+	return "\n\n--- WebSocket ping statistics ---\n"
+}
+
+func (e *EnglishLang) MsgStatisticsWSHandshake() string {
+	return "Handshakes: %d, Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgStatisticsWSReconnects() string {
+	return "Reconnects: %d\n"
+}
+
+// gRPC
+func (e *EnglishLang) OptGRPC() string {
+	return "gRPC mode: call grpc.health.v1.Health/Check on host:port, SERVING counts as success"
+}
+
+func (e *EnglishLang) OptGRPCService() string {
+	return "Service name to check in gRPC mode (default: the whole server)"
+}
+
+func (e *EnglishLang) ErrorRequiresGRPC() string {
+	return "%s requires --grpc"
+}
+
+func (e *EnglishLang) ErrorNotWithGRPC() string {
+	return "%s cannot be used with --grpc"
+}
+
+func (e *EnglishLang) ErrorGRPCRequiresTarget() string {
+	return "gRPC mode requires a target\n\nUsage: tcping --grpc [options] <host:port>\nTry 'tcping -h' for more information"
+}
+
+func (e *EnglishLang) ErrorGRPCTarget() string {
+	return "invalid gRPC target (expected host:port): %s"
+}
+
+func (e *EnglishLang) ErrorGRPCResponse() string {
+	return "invalid health check response"
+}
+
+func (e *EnglishLang) ErrorGRPCStatus() string {
+	return "grpc-status %s (%s): %s"
+}
+
+func (e *EnglishLang) ErrorGRPCHTTPStatus() string {
+	return "unexpected HTTP status %s"
+}
+
+func (e *EnglishLang) MsgGRPCPingStart() string {
+	return "gRPC health check to %s\n"
+}
+
+func (e *EnglishLang) MsgGRPCService() string {
+	return "Service: %s\n"
+}
+
+func (e *EnglishLang) MsgGRPCFailed() string {
+	return "gRPC %s: seq=%d failed: %v\n"
+}
+
+func (e *EnglishLang) MsgGRPCStatisticsTitle() string {
+	return "\n\n--- gRPC health check statistics ---\n"
+}
+
+func (e *EnglishLang) MsgStatisticsGRPCStatus() string {
+	return "Status %s: %d\n"
+}
+
+// Application protocols
+func (e *EnglishLang) OptProto() string {
+	return "After connecting, perform a protocol handshake: redis, mysql, postgres, memcached, mongodb, smtp, ssh, ftp"
+}
+
+func (e *EnglishLang) ErrorProtoTCPOnly() string {
+	return "--proto can only be used in TCP mode"
+}
+
+func (e *EnglishLang) ErrorProtoUnexpected() string {
+	return "unexpected response: %s"
+}
+
+func (e *EnglishLang) MsgProtoFailed() string {
+	return "%s: seq=%d connected in %.2fms but %s handshake failed: %v\n"
+}
+
+func (e *EnglishLang) MsgStatisticsProto() string {
+	return "Application handshake: %d, Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+// DNS
+func (e *EnglishLang) OptDNS() string {
+	return "DNS mode: send DNS queries to the target server (default port 53, 853 for dot)"
+}
+
+func (e *EnglishLang) OptDNSName() string {
+	return "Name to query in DNS mode (default: .)"
+}
+
+func (e *EnglishLang) OptDNSType() string {
+	return "Record type to query, e.g. A, AAAA, MX (default: NS)"
+}
+
+func (e *EnglishLang) OptDNSTransport() string {
+	return "DNS transport: udp, tcp or dot (DNS over TLS) (default: udp)"
+}
+
+func (e *EnglishLang) ErrorNotWithDNS() string {
+	return "%s cannot be used with --dns"
+}
+
+func (e *EnglishLang) ErrorDNSName() string {
+	return "invalid DNS name: %s"
+}
+
+func (e *EnglishLang) ErrorDNSReply() string {
+	return "invalid DNS response"
+}
+
+func (e *EnglishLang) MsgDNSPingStart() string {
+	return "DNS query to %s (%s - %s) port %s: %s %s over %s\n"
+}
+
+func (e *EnglishLang) MsgDNSFailed() string {
+	return "DNS %s: seq=%d failed: %v\n"
+}
+
+func (e *EnglishLang) MsgVerboseDNSConn() string {
+	return "  Connection: connect = %.2fms, TLS handshake = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgDNSStatisticsTitle() string {
+	return "\n\n--- DNS query statistics ---\n"
+}
+
+func (e *EnglishLang) MsgStatisticsDNSRcode() string {
+	return "Rcode %s: %d\n"
+}
+
+func (e *EnglishLang) MsgStatisticsDNSTruncated() string {
+	return "Truncated responses: %d\n"
+}
+
+// ICMP
+func (e *EnglishLang) OptICMP() string {
+	return "ICMP echo mode using unprivileged ping sockets (Linux)"
+}
+
+func (e *EnglishLang) OptCompareICMP() string {
+	return "Send an ICMP echo after each TCP probe and compare the RTTs"
+}
+
+func (e *EnglishLang) ErrorICMPNotPermitted() string {
+	return "ping sockets are not permitted for this user; allow the group with: sysctl -w net.ipv4.ping_group_range=\"0 2147483647\""
+}
+
+func (e *EnglishLang) ErrorICMPUnsupported() string {
+	return "--icmp requires Linux unprivileged ping sockets and is not supported on this platform"
+}
+
+func (e *EnglishLang) ErrorICMPPort() string {
+	return "--icmp does not take a port"
+}
+
+func (e *EnglishLang) ErrorICMPAddress() string {
+	return "invalid ICMP target address: %s"
+}
+
+func (e *EnglishLang) ErrorICMPTimeout() string {
+	return "no echo reply within %dms"
+}
+
+func (e *EnglishLang) ErrorNotWithICMP() string {
+	return "%s cannot be used with --icmp or --compare-icmp"
+}
+
+func (e *EnglishLang) MsgICMPPingStart() string {
+	return "Pinging %s (%s - %s) with ICMP echo\n"
+}
+
+func (e *EnglishLang) MsgICMPCompareUnavailable() string {
+	return "ICMP comparison disabled: %v\n"
+}
+
+func (e *EnglishLang) MsgICMPFailed() string {
+	return "ICMP %s: seq=%d failed: %v\n"
+}
+
+func (e *EnglishLang) MsgICMPStatisticsTitle() string {
+	return "\n\n--- ICMP ping statistics ---\n"
+}
+
+func (e *EnglishLang) MsgICMPCompareTitle() string {
+	return "\nTCP vs ICMP:\n"
+}
+
+func (e *EnglishLang) MsgICMPCompareRow() string {
+	return "  %-4s sent = %d, received = %d (%.1f%% loss), min/avg/max = %.2f/%.2f/%.2fms\n"
+}
+
+func (e *EnglishLang) MsgICMPCompareDiff() string {
+	return "  Average TCP - ICMP = %+.2fms\n"
+}
+
+func (e *EnglishLang) ErrorICMPUnixTarget() string {
+	return "--icmp and --compare-icmp cannot be used with unix: targets"
+}
+
+// NTP
+func (e *EnglishLang) OptNTP() string {
+	return "NTP mode: send SNTP requests and report clock offset (default port 123)"
+}
+
+func (e *EnglishLang) ErrorNotWithNTP() string {
+	return "%s cannot be used with --ntp"
+}
+
+func (e *EnglishLang) ErrorNTPKissCode() string {
+	return "server refused the request (kiss code %s)"
+}
+
+func (e *EnglishLang) ErrorNTPUnsynchronized() string {
+	return "server clock is not synchronized"
+}
+
+func (e *EnglishLang) ErrorNTPReply() string {
+	return "invalid NTP reply"
+}
+
+func (e *EnglishLang) MsgNTPPingStart() string {
+	return "Querying NTP server %s (%s - %s) port %s\n"
+}
+
+func (e *EnglishLang) MsgNTPFailed() string {
+	return "NTP %s: seq=%d failed: %v\n"
+}
+
+func (e *EnglishLang) MsgVerboseNTP() string {
+	return "  Server: leap = %d, root delay = %.2fms, root dispersion = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgNTPStatisticsTitle() string {
+	return "\n\n--- NTP query statistics ---\n"
+}
+
+func (e *EnglishLang) MsgStatisticsNTPOffset() string {
+	return "Clock offset: Min = %+.3fms, Max = %+.3fms, Avg = %+.3fms\n"
+}
+
+// Persistent connection
+func (e *EnglishLang) OptPersistent() string {
+	return "Keep one TCP connection open and time request/reply round trips on it"
+}
+
+func (e *EnglishLang) OptSend() string {
+	return "Data to send on each persistent probe (escapes like \\r\\n allowed; default: echo line)"
+}
+
+func (e *EnglishLang) OptExpect() string {
+	return "Reply must contain this data (default: any reply)"
+}
+
+func (e *EnglishLang) ErrorRequiresPersistent() string {
+	return "%s requires --persistent or --find-idle-timeout"
+}
+
+func (e *EnglishLang) ErrorExpectRequiresSend() string {
+	return "--expect requires --send"
+}
+
+func (e *EnglishLang) ErrorNotWithPersistent() string {
+	return "%s cannot be used with --persistent"
+}
+
+func (e *EnglishLang) ErrorPersistentTimeout() string {
+	return "no reply within %dms"
+}
+
+func (e *EnglishLang) MsgPersistentPingStart() string {
+	return "Keeping a persistent connection to %s\n"
+}
+
+func (e *EnglishLang) MsgPersistentConnectFailed() string {
+	return "TCP %s: seq=%d connect failed: %v\n"
+}
+
+func (e *EnglishLang) MsgPersistentDropped() string {
+	return "TCP %s: seq=%d connection dropped: %v (connected %v, idle %v)\n"
+}
+
+func (e *EnglishLang) MsgPersistentStatisticsTitle() string {
+	return "\n\n--- Persistent connection statistics ---\n"
+}
+
+func (e *EnglishLang) MsgStatisticsPersistentConnect() string {
+	return "Connections: %d, Min = %.2fms, Max = %.2fms, Avg = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgStatisticsPersistentDrops() string {
+	return "Drops: %d, Reconnects: %d\n"
+}
+
+func (e *EnglishLang) MsgStatisticsPersistentLifetime() string {
+	return "Lifetime of dropped connections: Min = %.1fs, Max = %.1fs, Avg = %.1fs\n"
+}
+
+// Idle timeout discovery
+func (e *EnglishLang) OptFindIdleTimeout() string {
+	return "Find how long an idle connection survives on the path (NAT/firewall timeout)"
+}
+
+func (e *EnglishLang) OptIdleMin() string {
+	return "Shortest idle time to test and search precision (default: 1s)"
+}
+
+func (e *EnglishLang) OptIdleMax() string {
+	return "Longest idle time to test (default: 10m)"
+}
+
+func (e *EnglishLang) ErrorNotWithFindIdle() string {
+	return "%s cannot be used with --find-idle-timeout"
+}
+
+func (e *EnglishLang) ErrorIdleRange() string {
+	return "--idle-min must be greater than 0 and not greater than --idle-max"
+}
+
+func (e *EnglishLang) MsgIdleSearchStart() string {
+	return "Searching the idle timeout of %s between %v and %v\n"
+}
+
+func (e *EnglishLang) MsgIdleWaiting() string {
+	return "seq=%d: keeping a new connection idle for %v\n"
+}
+
+func (e *EnglishLang) MsgIdleAlive() string {
+	return "TCP %s: seq=%d idle=%v alive time=%.2fms\n"
+}
+
+func (e *EnglishLang) MsgIdleDropped() string {
+	return "TCP %s: seq=%d idle=%v dropped: %v\n"
+}
+
+func (e *EnglishLang) MsgIdleSetupFailed() string {
+	return "TCP %s: seq=%d target unavailable: %v\n"
+}
+
+func (e *EnglishLang) MsgIdleStatisticsTitle() string {
+	return "\n\n--- Idle timeout ---\n"
+}
+
+func (e *EnglishLang) MsgIdleRange() string {
+	return "Idle connections are dropped after more than %v and at most %v\n"
+}
+
+func (e *EnglishLang) MsgIdleBelow() string {
+	return "Idle connections are dropped within %v\n"
+}
+
+func (e *EnglishLang) MsgIdleNone() string {
+	return "No idle timeout up to %v\n"
+}
+
+func (e *EnglishLang) MsgIdleAtLeast() string {
+	return "Idle connections survive at least %v (search incomplete)\n"
+}
+
+func (e *EnglishLang) MsgIdleAborted() string {
+	return "Search aborted: %v\n"
+}
+
+func (e *EnglishLang) MsgIdleChecks() string {
+	return "Checks: %d, target unavailable: %d\n"
+}
+
+// Load mode
+func (e *EnglishLang) OptRate() string {
+	return "Start probes at a fixed rate without waiting for earlier ones, e.g. 100/s or 600/m"
+}
+
+func (e *EnglishLang) OptConcurrency() string {
+	return "Maximum probes in flight; without --rate, run this many probes back to back"
+}
+
+func (e *EnglishLang) ErrorNotWithLoad() string {
+	return "%s cannot be used with --rate or --concurrency"
+}
+
+func (e *EnglishLang) MsgLoadStart() string {
+	return "Load mode: %.1f probes/s, at most %d in flight\n"
+}
+
+func (e *EnglishLang) MsgLoadStartUnlimited() string {
+	return "Load mode: no rate limit, %d probes in flight\n"
+}
+
+func (e *EnglishLang) MsgLoadTitle() string {
+	return "\n--- Load ---\n"
+}
+
+func (e *EnglishLang) MsgLoadTarget() string {
+	return "Target: %.1f probes/s, concurrency %d\n"
+}
+
+func (e *EnglishLang) MsgLoadUnlimited() string {
+	return "Target: no rate limit, concurrency %d\n"
+}
+
+func (e *EnglishLang) MsgLoadAchieved() string {
+	return "Launched: %d in %.1fs, achieved %.1f probes/s, skipped at concurrency limit: %d\n"
+}
+
+func (e *EnglishLang) MsgLoadLatency() string {
+	return "Latency: p50 %.2fms, p90 %.2fms, p99 %.2fms, p99.9 %.2fms, max %.2fms\n"
+}
+
+func (e *EnglishLang) MsgLoadErrors() string {
+	return "Errors: %s\n"
+}
+
+// Fixed-cadence scheduling
+func (e *EnglishLang) OptIntervalJitter() string {
+	return "Delay each probe by a random amount up to this, e.g. 200ms (at most the interval)"
+}
+
+func (e *EnglishLang) OptMaxInFlight() string {
+	return "Maximum probes in flight when a probe outlasts the interval (default: timeout / interval)"
+}
+
+func (e *EnglishLang) ErrorJitterRange() string {
+	return "--interval-jitter cannot be greater than the interval"
+}
+
+func (e *EnglishLang) ErrorNotWithMaxInFlight() string {
+	return "%s probes one at a time and cannot be used with --max-inflight"
+}
+
+func (e *EnglishLang) MsgScheduleSkipped() string {
+	return "Skipped %d probe slot(s): %d probe(s) still in flight\n"
+}
+
+func (e *EnglishLang) MsgScheduleLate() string {
+	return "seq=%d sent %v behind schedule (max in flight: %d)\n"
+}
+
+func (e *EnglishLang) MsgStatisticsSchedule() string {
+	return "Schedule: %d probe(s) sent late, %d slot(s) skipped\n"
+}
+
+// Adaptive timeout and interval
+func (e *EnglishLang) OptAdaptive() string {
+	return "Derive the timeout from observed RTT and probe faster after failures"
+}
+
+func (e *EnglishLang) MsgVerboseAdaptive() string {
+	return "  Adaptive: seq=%d timeout=%v interval=%v srtt=%v rttvar=%v\n"
+}
+
+func (e *EnglishLang) MsgStatisticsAdaptiveTimeout() string {
+	return "Adaptive timeout: Min = %.0fms, Max = %.0fms, Avg = %.0fms\n"
+}
+
+func (e *EnglishLang) MsgStatisticsAdaptiveInterval() string {
+	return "Adaptive interval: Min = %.0fms, Max = %.0fms, Avg = %.0fms\n"
+}
+
+// Recording and analyze
+func (e *EnglishLang) UsageAnalyze() string {
+	return "tcping analyze [options] <file>...              # Analyze recorded runs"
+}
+
+func (e *EnglishLang) OptRecord() string {
+	return "Append every probe result to a JSON Lines file for tcping analyze"
+}
+
+func (e *EnglishLang) OptAnalyzeFrom() string {
+	return "Only analyze records at or after this time"
+}
+
+func (e *EnglishLang) OptAnalyzeTo() string {
+	return "Only analyze records before this time"
+}
+
+func (e *EnglishLang) OptAnalyzeMerge() string {
+	return "Merge records of the same target from all files"
+}
+
+func (e *EnglishLang) OptAnalyzeJSON() string {
+	return "Print the analysis as JSON"
+}
+
+func (e *EnglishLang) ErrorRecordOpen() string {
+	return "cannot open record file %s: %v"
+}
+
+func (e *EnglishLang) ErrorRecordWrite() string {
+	return "failed to write record file %s: %v"
+}
+
+func (e *EnglishLang) ErrorRecordParse() string {
+	return "%s:%d: invalid record: %v"
+}
+
+func (e *EnglishLang) ErrorRecordTime() string {
+	return "invalid time %q, use RFC 3339 or 2006-01-02 15:04:05"
+}
+
+func (e *EnglishLang) ErrorAnalyzeNoFiles() string {
+	return "tcping analyze requires at least one record file"
+}
+
+func (e *EnglishLang) ErrorAnalyzeNoRecords() string {
+	return "no records in the selected time range"
+}
+
+func (e *EnglishLang) MsgAnalyzeTitle() string {
+	return "\n--- %s ---\n"
+}
+
+func (e *EnglishLang) MsgAnalyzeFiles() string {
+	return "Files: %s\n"
+}
+
+func (e *EnglishLang) MsgAnalyzeRange() string {
+	return "Records: %d, %s - %s (%v)\n"
+}
+
+func (e *EnglishLang) MsgAnalyzePercentiles() string {
+	return "Percentiles: P50 = %.2fms, P90 = %.2fms, P99 = %.2fms, P99.9 = %.2fms\n"
+}
+
+func (e *EnglishLang) MsgAnalyzeOutages() string {
+	return "Outages: %d\n"
+}
+
+func (e *EnglishLang) MsgAnalyzeOutageOpen() string {
+	return "end of recording"
+}
+
+func (e *EnglishLang) MsgAnalyzeCompareTitle() string {
+	return "\nComparison:\n"
+}
+
+func (e *EnglishLang) MsgAnalyzeCompareRow() string {
+	return "  %s: sent = %d, %.1f%% loss, P50/P99 = %.2f/%.2fms, outages = %d\n"
+}
+
+// Dashboard conflicts
+func (e *EnglishLang) ErrorNotWithTUI() string {
+	return "%s cannot be used with --tui"
+}
+
+// Idle timeout search: inconclusive checks
+func (e *EnglishLang) MsgIdleTimedOut() string {
+	return "TCP %s: seq=%d idle=%v inconclusive: %v\n"
+}
+
+func (e *EnglishLang) MsgIdleInconclusive() string {
+	return "After %v idle the reply timed out without a reset or close, so the search stopped; increase -w to tell a slow reply from a silent drop\n"
 }
\ No newline at end of file
@@ -104,6 +104,328 @@ type Language interface {
 	// IP type strings
 	IPv4String() string               // "IPv4"
 	IPv6String() string               // "IPv6"
+	
+	// Argument parsing
+	ErrorUnknownOption() string // "未知选项: %s"
+	ErrorOptionRequiresValue() string // "选项 %s 需要提供参数值"
+	ErrorInvalidOptionValue() string // "选项 %s 的参数值无效: %s"
+	ErrorOptionTakesNoValue() string // "选项 %s 不接受参数值"
+	ErrorTryHelp() string
+	
+	// Live dashboard
+	OptTUI() string
+	MsgTUIUnsupported() string
+	MsgTUITitle() string // "%s 实时仪表盘 - %s"
+	MsgTUIElapsed() string // "已运行 %s, 已发送探测 %d"
+	MsgTUIRTT() string
+	MsgTUILastRTT() string // "最近 %.2fms"
+	MsgTUILoss() string
+	MsgTUILossDetail() string // "%.1f%% (%d/%d 丢失)"
+	MsgTUIStatsLabel() string
+	MsgTUIStats() string // "最小 %.2fms  平均 %.2fms  最大 %.2fms  p50 %.2fms  p90 %.2fms  p99 %.2fms"
+	MsgTUILastError() string
+	MsgTUINone() string
+	MsgTUIOutages() string
+	MsgTUIOutageEntry() string // "%s - %s  失败探测 %d 次  %s"
+	MsgTUIOutageOngoing() string
+	MsgTUIHint() string
+	
+	// Interim statistics
+	OptSummaryEvery() string
+	OptSummaryWindow() string
+	MsgInterimTitle() string // "\n--- 阶段统计 %s (已运行 %s) ---\n"
+	MsgInterimWindow() string // "最近 %s:\n"
+	
+	// Stop conditions
+	OptDuration() string
+	OptUntilSuccess() string
+	OptUntilFailure() string
+	OptMaxFailures() string
+	MsgStopDuration() string // "\n已停止: 达到运行时间 %s\n"
+	MsgStopUntilSuccess() string
+	MsgStopUntilFailure() string
+	MsgStopMaxFailures() string // "\n已停止: 失败次数达到 %d 次 (--max-failures)\n"
+	
+	// Thresholds and verdict
+	OptMaxLoss() string // "丢失率超过此百分比时判定失败 (例如 5%)"
+	OptMaxAvgRTT() string
+	OptMaxP99RTT() string
+	OptJSON() string
+	MsgThresholdLoss() string // "丢失率 %.1f%% 超过上限 %.1f%%"
+	MsgThresholdAvgRTT() string // "平均 RTT %.2fms 超过上限 %.2fms"
+	MsgThresholdP99RTT() string // "p99 RTT %.2fms 超过上限 %.2fms"
+	MsgThresholdNoResponse() string
+	MsgVerdictPass() string
+	MsgVerdictFail() string // "判定: 未通过 (%s)\n"
+	
+	// HTTP transfer
+	MsgStatisticsBodyData() string // "响应体 (解压后): %d bytes (%.2f MB)\n"
+	MsgVerboseHTTPTransfer() string // "    耗时: 响应头 = %.2fms, 响应体 = %.2fms, 总计 = %.2fms\n"
+	
+	// Throughput test
+	OptThroughput() string
+	OptUploadSize() string
+	OptStreams() string
+	MsgThroughputDownload() string
+	MsgThroughputUpload() string
+	MsgThroughputStart() string // "吞吐量测试 (%s, %d 个传输流)，每 %s 采样一次\n"
+	MsgThroughputSample() string // "  %7.2fs  %10.2f Mbps  (%d bytes)\n"
+	MsgThroughputStreamFailed() string // "  传输流 %d 失败: %v\n"
+	MsgThroughputStreamDone() string // "  传输流 %d: %d bytes，耗时 %s\n"
+	MsgThroughputStatisticsTitle() string
+	MsgThroughputStreams() string // "传输流 = %d, 完成 = %d, 失败 = %d\n"
+	MsgThroughputTransferred() string // "传输量 (%s): %d bytes (%.2f MB)，耗时 %s\n"
+	MsgThroughputRampUp() string // "爬升时间: %s\n"
+	MsgThroughputSustained() string // "持续吞吐量: 最小 = %.2f Mbps, 最大 = %.2f Mbps, 平均 = %.2f Mbps\n"
+	MsgThroughputFinal() string // "最终吞吐量: %.2f Mbps\n"
+	MsgThroughputTTFB() string // "首字节时间: 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	
+	// HTTP connection reuse
+	OptHTTPReuse() string
+	OptHTTPFresh() string
+	ErrorRequiresHTTPMode() string // "%s 需要 HTTP 模式 (-H)"
+	ErrorHTTPReuseFresh() string
+	MsgVerboseHTTPConnReused() string // "    连接: 复用, 本地端口 %d, 空闲 %s\n"
+	MsgVerboseHTTPConnNew() string // "    连接: 新建, 本地端口 %d, 连接 = %.2fms, TLS 握手 = %.2fms\n"
+	MsgStatisticsReusedConns() string // "复用连接: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	MsgStatisticsNewConns() string // "新建连接: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	
+	// HTTP protocol version
+	OptHTTP2() string
+	OptHTTP3() string
+	ErrorHTTP2HTTP3() string
+	ErrorHTTP3RequiresHTTPS() string
+	
+	// HTTP redirects
+	OptFollow() string
+	OptMaxRedirs() string
+	ErrorRedirectDowngrade() string // "重定向从 https 降级为 http: %v -> %v"
+	ErrorRedirectLoop() string // "检测到重定向循环: %v"
+	ErrorTooManyRedirects() string // "已重定向 %d 次，停止跟随"
+	MsgVerboseHTTPRedirect() string // "    重定向 %d: %d %s -> %s (%.2fms)\n"
+	
+	// TLS options
+	OptCACert() string
+	OptCert() string
+	OptKey() string
+	OptTLSMin() string
+	OptTLSMax() string
+	OptCiphers() string
+	OptSNI() string
+	ErrorTLSVersionRange() string
+	ErrorTLSLoadCACert() string // "无法读取 CA 证书文件 %s: %v"
+	ErrorTLSNoCACerts() string // "%s 中没有找到 PEM 证书"
+	ErrorTLSKeyWithoutCert() string
+	ErrorTLSLoadClientCert() string // "无法加载客户端证书: %v"
+	ErrorTLSUnknownAuthority() string // "证书由未知的证书颁发机构签发 (颁发者: %s)，可使用 --cacert 信任"
+	ErrorTLSHostname() string // "证书对 %s 无效 (有效名称: %s)"
+	ErrorTLSExpired() string // "证书已过期或尚未生效 (有效期 %s 至 %s)"
+	ErrorTLSInvalidCert() string // "证书无效: %v"
+	ErrorTLSNotTLS() string
+	ErrorTLSRemoteAlert() string // "服务器拒绝了 TLS 握手: %v"
+	MsgVerboseHTTPTLS() string // "    TLS: %s, %s, 服务器名称 %s\n"
+	
+	// Proxy
+	OptProxy() string
+	OptProxyEnv() string
+	ErrorProxyURL() string // "无效的代理地址: %s"
+	ErrorProxyScheme() string // "不支持的代理协议 %q (请使用 http、socks5 或 socks5h)"
+	ErrorProxyHTTP3() string
+	ErrorProxyDial() string // "无法连接到代理 %v: %v"
+	ErrorProxyConnect() string // "代理拒绝了 CONNECT: %s"
+	ErrorSOCKSProtocol() string
+	ErrorSOCKSNoMethod() string
+	ErrorSOCKSAuth() string
+	ErrorSOCKSReply() string // "SOCKS5 代理无法连接到目标: %s"
+	MsgViaProxy() string // "使用代理 %s\n"
+	MsgTCPPingStartProxy() string // "正在对 %s 端口 %s 执行 TCP Ping (地址由代理解析)\n"
+	MsgVerboseHTTPProxy() string // "    代理: %s, 连接代理 = %.2fms, 建立隧道 = %.2fms\n"
+	MsgStatisticsProxyConnect() string // "连接代理: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	MsgStatisticsProxyTunnel() string // "到目标的隧道: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	
+	// Unix domain sockets
+	OptUnixSocket() string
+	ErrorUnixSocketConflict() string
+	ErrorUnixTargetPort() string
+	MsgUnixPingStart() string // "正在连接 Unix 域套接字 %s\n"
+	MsgViaUnixSocket() string // "使用 Unix 域套接字 %s\n"
+	
+	// WebSocket
+	OptWebSocket() string
+	ErrorNotWithWebSocket() string // "%s 不能与 --ws 同时使用"
+	ErrorWSRequiresURI() string
+	ErrorWSURI() string // "WebSocket URI 必须以 ws:// 或 wss:// 开头: %s"
+	ErrorWSHandshakeStatus() string // "WebSocket 握手被拒绝: %s"
+	ErrorWSHandshakeInvalid() string
+	ErrorWSProtocol() string
+	ErrorWSClosed() string // "服务器关闭了连接 (代码 %d %s)"
+	ErrorWSPongTimeout() string // "%dms 内没有收到 pong"
+	MsgWSPingStart() string // "正在对 %s 执行 WebSocket ping\n"
+	MsgWSFailed() string // "WebSocket %s: seq=%d 失败: %v\n"
+	MsgVerboseWSHandshake() string // "  握手: 总计 = %.2fms, 连接 = %.2fms, TLS 握手 = %.2fms\n"
+	MsgWSStatisticsTitle() string
+	MsgStatisticsWSHandshake() string // "握手: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	MsgStatisticsWSReconnects() string // "重连: %d\n"
+	
+	// gRPC
+	OptGRPC() string
+	OptGRPCService() string
+	ErrorRequiresGRPC() string // "%s 需要与 --grpc 一起使用"
+	ErrorNotWithGRPC() string // "%s 不能与 --grpc 同时使用"
+	ErrorGRPCRequiresTarget() string
+	ErrorGRPCTarget() string // "无效的 gRPC 目标 (应为 host:port): %s"
+	ErrorGRPCResponse() string
+	ErrorGRPCStatus() string // "grpc-status %s (%s): %s"
+	ErrorGRPCHTTPStatus() string // "意外的 HTTP 状态 %s"
+	MsgGRPCPingStart() string // "正在对 %s 执行 gRPC 健康检查\n"
+	MsgGRPCService() string // "服务: %s\n"
+	MsgGRPCFailed() string // "gRPC %s: seq=%d 失败: %v\n"
+	MsgGRPCStatisticsTitle() string
+	MsgStatisticsGRPCStatus() string // "状态 %s: %d\n"
+	
+	// Application protocols
+	OptProto() string
+	ErrorProtoTCPOnly() string
+	ErrorProtoUnexpected() string // "意外的响应: %s"
+	MsgProtoFailed() string // "%s: seq=%d 已在 %.2fms 内连接，但 %s 握手失败: %v\n"
+	MsgStatisticsProto() string // "应用层握手: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	
+	// DNS
+	OptDNS() string
+	OptDNSName() string
+	OptDNSType() string
+	OptDNSTransport() string
+	ErrorNotWithDNS() string // "%s 不能与 --dns 同时使用"
+	ErrorDNSName() string // "无效的 DNS 名称: %s"
+	ErrorDNSReply() string
+	MsgDNSPingStart() string // "正在对 %s (%s - %s) 端口 %s 执行 DNS 查询: %s %s (%s)\n"
+	MsgDNSFailed() string // "DNS %s: seq=%d 失败: %v\n"
+	MsgVerboseDNSConn() string // "  连接: 连接 = %.2fms, TLS 握手 = %.2fms\n"
+	MsgDNSStatisticsTitle() string
+	MsgStatisticsDNSRcode() string // "响应码 %s: %d\n"
+	MsgStatisticsDNSTruncated() string // "被截断的响应: %d\n"
+	
+	// ICMP
+	OptICMP() string
+	OptCompareICMP() string
+	ErrorICMPNotPermitted() string
+	ErrorICMPUnsupported() string
+	ErrorICMPPort() string
+	ErrorICMPAddress() string // "无效的 ICMP 目标地址: %s"
+	ErrorICMPTimeout() string // "%dms 内没有收到回显应答"
+	ErrorNotWithICMP() string // "%s 不能与 --icmp 或 --compare-icmp 同时使用"
+	MsgICMPPingStart() string // "正在对 %s (%s - %s) 执行 ICMP Ping\n"
+	MsgICMPCompareUnavailable() string // "已禁用 ICMP 对比: %v\n"
+	MsgICMPFailed() string // "ICMP %s: seq=%d 失败: %v\n"
+	MsgICMPStatisticsTitle() string
+	MsgICMPCompareTitle() string
+	MsgICMPCompareRow() string // "  %-4s 已发送 = %d, 已接收 = %d (%.1f%% 丢失), 最小/平均/最大 = %.2f/%.2f/%.2fms\n"
+	MsgICMPCompareDiff() string // "  平均 TCP - ICMP = %+.2fms\n"
+	ErrorICMPUnixTarget() string
+	
+	// NTP
+	OptNTP() string
+	ErrorNotWithNTP() string // "%s 不能与 --ntp 同时使用"
+	ErrorNTPKissCode() string // "服务器拒绝了请求 (kiss 代码 %s)"
+	ErrorNTPUnsynchronized() string
+	ErrorNTPReply() string
+	MsgNTPPingStart() string // "正在查询 NTP 服务器 %s (%s - %s) 端口 %s\n"
+	MsgNTPFailed() string // "NTP %s: seq=%d 失败: %v\n"
+	MsgVerboseNTP() string // "  服务器: 闰秒指示 = %d, 根延迟 = %.2fms, 根离散 = %.2fms\n"
+	MsgNTPStatisticsTitle() string
+	MsgStatisticsNTPOffset() string // "时钟偏移: 最小 = %+.3fms, 最大 = %+.3fms, 平均 = %+.3fms\n"
+	
+	// Persistent connection
+	OptPersistent() string
+	OptSend() string
+	OptExpect() string
+	ErrorRequiresPersistent() string // "%s 需要与 --persistent 或 --find-idle-timeout 一起使用"
+	ErrorExpectRequiresSend() string
+	ErrorNotWithPersistent() string // "%s 不能与 --persistent 同时使用"
+	ErrorPersistentTimeout() string // "%dms 内没有收到应答"
+	MsgPersistentPingStart() string // "正在保持到 %s 的持久连接\n"
+	MsgPersistentConnectFailed() string // "TCP %s: seq=%d 连接失败: %v\n"
+	MsgPersistentDropped() string // "TCP %s: seq=%d 连接断开: %v (已连接 %v，空闲 %v)\n"
+	MsgPersistentStatisticsTitle() string
+	MsgStatisticsPersistentConnect() string // "连接: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+	MsgStatisticsPersistentDrops() string // "断开: %d, 重连: %d\n"
+	MsgStatisticsPersistentLifetime() string // "断开连接的存活时间: 最小 = %.1fs, 最大 = %.1fs, 平均 = %.1fs\n"
+	
+	// Idle timeout discovery
+	OptFindIdleTimeout() string
+	OptIdleMin() string
+	OptIdleMax() string
+	ErrorNotWithFindIdle() string // "%s 不能与 --find-idle-timeout 同时使用"
+	ErrorIdleRange() string
+	MsgIdleSearchStart() string // "正在搜索 %s 的空闲超时，范围 %v 到 %v\n"
+	MsgIdleWaiting() string // "seq=%d: 保持新连接空闲 %v\n"
+	MsgIdleAlive() string // "TCP %s: seq=%d 空闲=%v 存活 time=%.2fms\n"
+	MsgIdleDropped() string // "TCP %s: seq=%d 空闲=%v 已断开: %v\n"
+	MsgIdleSetupFailed() string // "TCP %s: seq=%d 目标不可用: %v\n"
+	MsgIdleStatisticsTitle() string
+	MsgIdleRange() string // "空闲连接在超过 %v、不超过 %v 后被断开\n"
+	MsgIdleBelow() string // "空闲连接在 %v 内被断开\n"
+	MsgIdleNone() string // "在 %v 内没有空闲超时\n"
+	MsgIdleAtLeast() string // "空闲连接至少能保持 %v (搜索未完成)\n"
+	MsgIdleAborted() string // "搜索已中止: %v\n"
+	MsgIdleChecks() string // "检查: %d, 目标不可用: %d\n"
+	
+	// Load mode
+	OptRate() string
+	OptConcurrency() string
+	ErrorNotWithLoad() string // "%s 不能与 --rate 或 --concurrency 同时使用"
+	MsgLoadStart() string // "负载模式: 每秒 %.1f 次探测，最多同时进行 %d 次\n"
+	MsgLoadStartUnlimited() string // "负载模式: 不限速，同时进行 %d 次探测\n"
+	MsgLoadTitle() string
+	MsgLoadTarget() string // "目标: 每秒 %.1f 次探测，并发 %d\n"
+	MsgLoadUnlimited() string // "目标: 不限速，并发 %d\n"
+	MsgLoadAchieved() string // "已发起: %d 次，耗时 %.1f 秒，实际每秒 %.1f 次探测，因并发上限跳过: %d\n"
+	MsgLoadLatency() string // "延迟: p50 %.2fms, p90 %.2fms, p99 %.2fms, p99.9 %.2fms, 最大 %.2fms\n"
+	MsgLoadErrors() string // "错误: %s\n"
+	
+	// Fixed-cadence scheduling
+	OptIntervalJitter() string
+	OptMaxInFlight() string
+	ErrorJitterRange() string
+	ErrorNotWithMaxInFlight() string // "%s 只能逐次探测，不能与 --max-inflight 同时使用"
+	MsgScheduleSkipped() string // "已跳过 %d 个探测时间点: 仍有 %d 次探测在进行\n"
+	MsgScheduleLate() string // "seq=%d 比计划晚 %v 发出 (同时进行上限: %d)\n"
+	MsgStatisticsSchedule() string // "调度: 延后发出 %d 次，跳过 %d 个时间点\n"
+	
+	// Adaptive timeout and interval
+	OptAdaptive() string
+	MsgVerboseAdaptive() string // "  自适应: seq=%d 超时=%v 间隔=%v srtt=%v rttvar=%v\n"
+	MsgStatisticsAdaptiveTimeout() string // "自适应超时: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+	MsgStatisticsAdaptiveInterval() string // "自适应间隔: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+	
+	// Recording and analyze
+	UsageAnalyze() string
+	OptRecord() string
+	OptAnalyzeFrom() string
+	OptAnalyzeTo() string
+	OptAnalyzeMerge() string
+	OptAnalyzeJSON() string
+	ErrorRecordOpen() string // "无法打开记录文件 %s: %v"
+	ErrorRecordWrite() string // "写入记录文件 %s 失败: %v"
+	ErrorRecordParse() string // "%s:%d: 无效的记录: %v"
+	ErrorRecordTime() string // "无效的时间 %q，请使用 RFC 3339 或 2006-01-02 15:04:05 格式"
+	ErrorAnalyzeNoFiles() string
+	ErrorAnalyzeNoRecords() string
+	MsgAnalyzeTitle() string // "\n--- %s ---\n"
+	MsgAnalyzeFiles() string // "文件: %s\n"
+	MsgAnalyzeRange() string // "记录: %d 条, %s - %s (%v)\n"
+	MsgAnalyzePercentiles() string // "百分位数: P50 = %.2fms, P90 = %.2fms, P99 = %.2fms, P99.9 = %.2fms\n"
+	MsgAnalyzeOutages() string // "中断: %d 次\n"
+	MsgAnalyzeOutageOpen() string
+	MsgAnalyzeCompareTitle() string
+	MsgAnalyzeCompareRow() string // "  %s: 已发送 = %d, 丢失 %.1f%%, P50/P99 = %.2f/%.2fms, 中断 = %d\n"
+	
+	// Dashboard conflicts
+	ErrorNotWithTUI() string // "%s 不能与 --tui 同时使用"
+	
+	// Idle timeout search: inconclusive checks
+	MsgIdleTimedOut() string // "TCP %s: seq=%d 空闲=%v 无法判定: %v\n"
+	MsgIdleInconclusive() string // "空闲 %v 后请求超时，未收到重置或关闭，无法确定连接是否已断开，搜索已停止；增大 -w 可以区分应答缓慢和静默丢弃\n"
 }
 
 // Global language instance
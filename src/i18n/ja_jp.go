@@ -299,11 +299,11 @@ func (j *JapaneseLang) MsgStatisticsRTT() string {
 }
 
 func (j *JapaneseLang) MsgStatisticsTotalData() string {
-	return "総転送データ: %d bytes (%.2f MB)\n"
+	return "総受信データ (回線上): %d bytes (%.2f MB)\n"
 }
 
 func (j *JapaneseLang) MsgStatisticsBandwidth() string {
-	return "推定帯域幅: 最小 = %.2f Mbps、最大 = %.2f Mbps、平均 = %.2f Mbps\n"
+	return "グッドプット (本文 / 総時間): 最小 = %.2f Mbps、最大 = %.2f Mbps、平均 = %.2f Mbps\n"
 }
 
 // IP type strings
@@ -313,4 +313,1103 @@ func (j *JapaneseLang) IPv4String() string {
 
 func (j *JapaneseLang) IPv6String() string {
 	return "IPv6"
+}
+
+// Argument parsing
+func (j *JapaneseLang) ErrorUnknownOption() string {
+	return "不明なオプション: %s"
+}
+
+func (j *JapaneseLang) ErrorOptionRequiresValue() string {
+	return "オプション %s には値が必要です"
+}
+
+func (j *JapaneseLang) ErrorInvalidOptionValue() string {
+	return "オプション %s の値が無効です: %s"
+}
+
+func (j *JapaneseLang) ErrorOptionTakesNoValue() string {
+	return "オプション %s は値を取りません"
+}
+
+func (j *JapaneseLang) ErrorTryHelp() string {
+	return "詳細は 'tcping -h' を参照してください"
+}
+
+// Live dashboard
+func (j *JapaneseLang) OptTUI() string {
+	return "RTTスパークライン付きのフルスクリーンライブダッシュボード"
+}
+
+func (j *JapaneseLang) MsgTUIUnsupported() string {
+	return "端末がライブダッシュボードに対応していないため、行出力に切り替えます\n"
+}
+
+func (j *JapaneseLang) MsgTUITitle() string {
+	return "%s ライブダッシュボード - %s"
+}
+
+func (j *JapaneseLang) MsgTUIElapsed() string {
+	return "経過時間 %s, 送信済みプローブ %d"
+}
+
+func (j *JapaneseLang) MsgTUIRTT() string {
+	return "RTT"
+}
+
+func (j *JapaneseLang) MsgTUILastRTT() string {
+	return "最新 %.2fms"
+}
+
+func (j *JapaneseLang) MsgTUILoss() string {
+	return "損失"
+}
+
+func (j *JapaneseLang) MsgTUILossDetail() string {
+	return "%.1f%% (%d/%d 損失)"
+}
+
+func (j *JapaneseLang) MsgTUIStatsLabel() string {
+	return "統計"
+}
+
+func (j *JapaneseLang) MsgTUIStats() string {
+	return "最小 %.2fms  平均 %.2fms  最大 %.2fms  p50 %.2fms  p90 %.2fms  p99 %.2fms"
+}
+
+func (j *JapaneseLang) MsgTUILastError() string {
+	return "最新エラー"
+}
+
+func (j *JapaneseLang) MsgTUINone() string {
+	return "なし"
+}
+
+func (j *JapaneseLang) MsgTUIOutages() string {
+	return "停止期間"
+}
+
+func (j *JapaneseLang) MsgTUIOutageEntry() string {
+	return "%s - %s  失敗プローブ %d 回  %s"
+}
+
+func (j *JapaneseLang) MsgTUIOutageOngoing() string {
+	return "継続中"
+}
+
+func (j *JapaneseLang) MsgTUIHint() string {
+	return "Ctrl-C で停止"
+}
+
+// Interim statistics
+func (j *JapaneseLang) OptSummaryEvery() string {
+	return "中間統計を定期的に表示 (例: 60s)。SIGQUIT/SIGUSR1 でも随時表示"
+}
+
+func (j *JapaneseLang) OptSummaryWindow() string {
+	return "中間統計に直近区間の統計も含める"
+}
+
+func (j *JapaneseLang) MsgInterimTitle() string {
+	return "\n--- 中間統計 %s (経過 %s) ---\n"
+}
+
+func (j *JapaneseLang) MsgInterimWindow() string {
+	return "直近 %s:\n"
+}
+
+// Stop conditions
+func (j *JapaneseLang) OptDuration() string {
+	return "指定した時間の経過後に停止 (例: 10m)"
+}
+
+func (j *JapaneseLang) OptUntilSuccess() string {
+	return "最初の成功で停止 (ポートが開くまで待機)"
+}
+
+func (j *JapaneseLang) OptUntilFailure() string {
+	return "最初の失敗で停止"
+}
+
+func (j *JapaneseLang) OptMaxFailures() string {
+	return "失敗の累計がこの回数に達したら停止"
+}
+
+func (j *JapaneseLang) MsgStopDuration() string {
+	return "\n停止: 実行時間 %s に達しました\n"
+}
+
+func (j *JapaneseLang) MsgStopUntilSuccess() string {
+	return "\n停止: ターゲットが応答しました (--until-success)\n"
+}
+
+func (j *JapaneseLang) MsgStopUntilFailure() string {
+	return "\n停止: プローブが失敗しました (--until-failure)\n"
+}
+
+func (j *JapaneseLang) MsgStopMaxFailures() string {
+	return "\n停止: 失敗が %d 回に達しました (--max-failures)\n"
+}
+
+// Thresholds and verdict
+func (j *JapaneseLang) OptMaxLoss() string {
+	return "損失率がこの割合を超えたら失敗とする (例: 5%)"
+}
+
+func (j *JapaneseLang) OptMaxAvgRTT() string {
+	return "平均 RTT がこの時間を超えたら失敗とする (例: 50ms)"
+}
+
+func (j *JapaneseLang) OptMaxP99RTT() string {
+	return "p99 RTT がこの時間を超えたら失敗とする"
+}
+
+func (j *JapaneseLang) OptJSON() string {
+	return "最終統計と判定を JSON で出力"
+}
+
+func (j *JapaneseLang) MsgThresholdLoss() string {
+	return "損失率 %.1f%% が上限 %.1f%% を超えています"
+}
+
+func (j *JapaneseLang) MsgThresholdAvgRTT() string {
+	return "平均 RTT %.2fms が上限 %.2fms を超えています"
+}
+
+func (j *JapaneseLang) MsgThresholdP99RTT() string {
+	return "p99 RTT %.2fms が上限 %.2fms を超えています"
+}
+
+func (j *JapaneseLang) MsgThresholdNoResponse() string {
+	return "成功した応答がありません"
+}
+
+func (j *JapaneseLang) MsgVerdictPass() string {
+	return "判定: 合格\n"
+}
+
+func (j *JapaneseLang) MsgVerdictFail() string {
+	return "判定: 不合格 (%s)\n"
+}
+
+// HTTP transfer
+func (j *JapaneseLang) MsgStatisticsBodyData() string {
+	return "レスポンス本文 (展開後): %d bytes (%.2f MB)\n"
+}
+
+func (j *JapaneseLang) MsgVerboseHTTPTransfer() string {
+	return "    タイミング: ヘッダー = %.2fms、本文 = %.2fms、合計 = %.2fms\n"
+}
+
+// Throughput test
+func (j *JapaneseLang) OptThroughput() string {
+	return "ヘルスチェックの代わりに HTTP の持続スループットを測定"
+}
+
+func (j *JapaneseLang) OptUploadSize() string {
+	return "ダウンロードの代わりに指定サイズ (例: 10M) の生成データをアップロード"
+}
+
+func (j *JapaneseLang) OptStreams() string {
+	return "並列スループットストリーム数 (既定値 1)"
+}
+
+func (j *JapaneseLang) MsgThroughputDownload() string {
+	return "ダウンロード"
+}
+
+func (j *JapaneseLang) MsgThroughputUpload() string {
+	return "アップロード"
+}
+
+func (j *JapaneseLang) MsgThroughputStart() string {
+	return "スループットテスト (%s、ストリーム %d 本)、%s ごとにサンプリング\n"
+}
+
+func (j *JapaneseLang) MsgThroughputSample() string {
+	return "  %7.2fs  %10.2f Mbps  (%d bytes)\n"
+}
+
+func (j *JapaneseLang) MsgThroughputStreamFailed() string {
+	return "  ストリーム %d が失敗しました: %v\n"
+}
+
+func (j *JapaneseLang) MsgThroughputStreamDone() string {
+	return "  ストリーム %d: %d bytes / %s\n"
+}
+
+func (j *JapaneseLang) MsgThroughputStatisticsTitle() string {
+	return "\n--- HTTP スループット統計 ---\n"
+}
+
+func (j *JapaneseLang) MsgThroughputStreams() string {
+	return "ストリーム = %d、完了 = %d、失敗 = %d\n"
+}
+
+func (j *JapaneseLang) MsgThroughputTransferred() string {
+	return "転送量 (%s): %d bytes (%.2f MB)、所要時間 %s\n"
+}
+
+func (j *JapaneseLang) MsgThroughputRampUp() string {
+	return "立ち上がり時間: %s\n"
+}
+
+func (j *JapaneseLang) MsgThroughputSustained() string {
+	return "持続スループット: 最小 = %.2f Mbps、最大 = %.2f Mbps、平均 = %.2f Mbps\n"
+}
+
+func (j *JapaneseLang) MsgThroughputFinal() string {
+	return "最終スループット: %.2f Mbps\n"
+}
+
+func (j *JapaneseLang) MsgThroughputTTFB() string {
+	return "最初のバイトまでの時間: 最小 = %.2fms、最大 = %.2fms、平均 = %.2fms\n"
+}
+
+// HTTP connection reuse
+func (j *JapaneseLang) OptHTTPReuse() string {
+	return "HTTP プローブ間で keep-alive 接続を再利用 (既定)"
+}
+
+func (j *JapaneseLang) OptHTTPFresh() string {
+	return "HTTP プローブごとに新しい TCP/TLS 接続を確立"
+}
+
+func (j *JapaneseLang) ErrorRequiresHTTPMode() string {
+	return "%s には HTTP モード (-H) が必要です"
+}
+
+func (j *JapaneseLang) ErrorHTTPReuseFresh() string {
+	return "--http-reuse と --http-fresh は同時に指定できません"
+}
+
+func (j *JapaneseLang) MsgVerboseHTTPConnReused() string {
+	return "    接続: 再利用、ローカルポート %d、アイドル時間 %s\n"
+}
+
+func (j *JapaneseLang) MsgVerboseHTTPConnNew() string {
+	return "    接続: 新規、ローカルポート %d、接続 = %.2fms、TLS ハンドシェイク = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsReusedConns() string {
+	return "再利用接続: %d、最小 = %.2fms、最大 = %.2fms、平均 = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsNewConns() string {
+	return "新規接続: %d、最小 = %.2fms、最大 = %.2fms、平均 = %.2fms\n"
+}
+
+// HTTP protocol version
+func (j *JapaneseLang) OptHTTP2() string {
+	return "HTTP/2 を強制 (https は ALPN で h2、http は h2c prior knowledge)"
+}
+
+func (j *JapaneseLang) OptHTTP3() string {
+	return "QUIC 上の HTTP/3 を使用 (https のみ)"
+}
+
+func (j *JapaneseLang) ErrorHTTP2HTTP3() string {
+	return "--http2 と --http3 は同時に指定できません"
+}
+
+func (j *JapaneseLang) ErrorHTTP3RequiresHTTPS() string {
+	return "--http3 には https:// の URI が必要です"
+}
+
+// HTTP redirects
+func (j *JapaneseLang) OptFollow() string {
+	return "HTTP リダイレクトを追跡し最終レスポンスを表示"
+}
+
+func (j *JapaneseLang) OptMaxRedirs() string {
+	return "追跡するリダイレクトの最大回数 (デフォルト 10)"
+}
+
+func (j *JapaneseLang) ErrorRedirectDowngrade() string {
+	return "https から http へのダウングレードリダイレクト: %v -> %v"
+}
+
+func (j *JapaneseLang) ErrorRedirectLoop() string {
+	return "リダイレクトループを検出: %v"
+}
+
+func (j *JapaneseLang) ErrorTooManyRedirects() string {
+	return "%d 回のリダイレクト後に停止しました"
+}
+
+func (j *JapaneseLang) MsgVerboseHTTPRedirect() string {
+	return "    リダイレクト %d: %d %s -> %s (%.2fms)\n"
+}
+
+// TLS options
+func (j *JapaneseLang) OptCACert() string {
+	return "システムのルート証明書の代わりにこの PEM ファイルの CA 証明書を信頼"
+}
+
+func (j *JapaneseLang) OptCert() string {
+	return "相互 TLS 用のクライアント証明書 (PEM)"
+}
+
+func (j *JapaneseLang) OptKey() string {
+	return "--cert の秘密鍵 (PEM) (デフォルト: --cert ファイルから読み込み)"
+}
+
+func (j *JapaneseLang) OptTLSMin() string {
+	return "TLS の最小バージョン (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (j *JapaneseLang) OptTLSMax() string {
+	return "TLS の最大バージョン (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (j *JapaneseLang) OptCiphers() string {
+	return "TLS 1.2 以下で許可する暗号スイート (カンマ区切り)"
+}
+
+func (j *JapaneseLang) OptSNI() string {
+	return "TLS ハンドシェイクで送信し証明書の検証に使うサーバー名"
+}
+
+func (j *JapaneseLang) ErrorTLSVersionRange() string {
+	return "--tls-min は --tls-max より高くできません"
+}
+
+func (j *JapaneseLang) ErrorTLSLoadCACert() string {
+	return "CA 証明書ファイル %s を読み込めません: %w"
+}
+
+func (j *JapaneseLang) ErrorTLSNoCACerts() string {
+	return "%s に PEM 証明書が見つかりません"
+}
+
+func (j *JapaneseLang) ErrorTLSKeyWithoutCert() string {
+	return "--key には --cert が必要です"
+}
+
+func (j *JapaneseLang) ErrorTLSLoadClientCert() string {
+	return "クライアント証明書を読み込めません: %w"
+}
+
+func (j *JapaneseLang) ErrorTLSUnknownAuthority() string {
+	return "不明な認証局によって署名された証明書です (発行者: %s)。--cacert で信頼できます"
+}
+
+func (j *JapaneseLang) ErrorTLSHostname() string {
+	return "証明書は %s に対して有効ではありません (有効な名前: %s)"
+}
+
+func (j *JapaneseLang) ErrorTLSExpired() string {
+	return "証明書の有効期限が切れているか、まだ有効ではありません (有効期間 %s 〜 %s)"
+}
+
+func (j *JapaneseLang) ErrorTLSInvalidCert() string {
+	return "証明書が無効です: %v"
+}
+
+func (j *JapaneseLang) ErrorTLSNotTLS() string {
+	return "サーバーが TLS で応答しませんでした (http:// のポートではありませんか?)"
+}
+
+func (j *JapaneseLang) ErrorTLSRemoteAlert() string {
+	return "サーバーが TLS ハンドシェイクを拒否しました: %v"
+}
+
+func (j *JapaneseLang) MsgVerboseHTTPTLS() string {
+	return "    TLS: %s, %s, サーバー名 %s\n"
+}
+
+// Proxy
+func (j *JapaneseLang) OptProxy() string {
+	return "HTTP CONNECT または SOCKS5 プロキシ経由で接続 (http://, socks5://, socks5h://, user:pass@ 対応)"
+}
+
+func (j *JapaneseLang) OptProxyEnv() string {
+	return "HTTP_PROXY/HTTPS_PROXY のプロキシを使用 (NO_PROXY に従う)"
+}
+
+func (j *JapaneseLang) ErrorProxyURL() string {
+	return "無効なプロキシアドレス: %s"
+}
+
+func (j *JapaneseLang) ErrorProxyScheme() string {
+	return "サポートされていないプロキシスキーム %q (http, socks5, socks5h を使用してください)"
+}
+
+func (j *JapaneseLang) ErrorProxyHTTP3() string {
+	return "--http3 はプロキシ経由では使用できません"
+}
+
+func (j *JapaneseLang) ErrorProxyDial() string {
+	return "プロキシ %v に接続できません: %v"
+}
+
+func (j *JapaneseLang) ErrorProxyConnect() string {
+	return "プロキシが CONNECT を拒否しました: %s"
+}
+
+func (j *JapaneseLang) ErrorSOCKSProtocol() string {
+	return "プロキシからの SOCKS5 応答が不正です"
+}
+
+func (j *JapaneseLang) ErrorSOCKSNoMethod() string {
+	return "SOCKS5 プロキシがサポートされていない認証方式を要求しました"
+}
+
+func (j *JapaneseLang) ErrorSOCKSAuth() string {
+	return "SOCKS5 プロキシの認証に失敗しました"
+}
+
+func (j *JapaneseLang) ErrorSOCKSReply() string {
+	return "SOCKS5 プロキシがターゲットに接続できませんでした: %s"
+}
+
+func (j *JapaneseLang) MsgViaProxy() string {
+	return "プロキシ %s を使用\n"
+}
+
+func (j *JapaneseLang) MsgTCPPingStartProxy() string {
+	return "%s ポート %s に TCP Ping を実行中 (アドレスはプロキシが解決)\n"
+}
+
+func (j *JapaneseLang) MsgVerboseHTTPProxy() string {
+	return "    プロキシ: %s, プロキシ接続 = %.2fms, トンネル = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsProxyConnect() string {
+	return "プロキシ接続: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsProxyTunnel() string {
+	return "ターゲットへのトンネル: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// Unix domain sockets
+func (j *JapaneseLang) OptUnixSocket() string {
+	return "この Unix ドメインソケット経由で HTTP プローブを送信 (URI はパスと Host のみに使用)"
+}
+
+func (j *JapaneseLang) ErrorUnixSocketConflict() string {
+	return "Unix ドメインソケットは --proxy や --http3 と併用できません"
+}
+
+func (j *JapaneseLang) ErrorUnixTargetPort() string {
+	return "unix: ターゲットにはポートを指定できません"
+}
+
+func (j *JapaneseLang) MsgUnixPingStart() string {
+	return "Unix ドメインソケット %s に接続中\n"
+}
+
+func (j *JapaneseLang) MsgViaUnixSocket() string {
+	return "Unix ドメインソケット %s を使用\n"
+}
+
+// WebSocket
+func (j *JapaneseLang) OptWebSocket() string {
+	return "WebSocket モード: ws:// または wss:// の URI とハンドシェイクし ping/pong の往復時間を測定"
+}
+
+func (j *JapaneseLang) ErrorNotWithWebSocket() string {
+	return "%s は --ws と併用できません"
+}
+
+func (j *JapaneseLang) ErrorWSRequiresURI() string {
+	return "WebSocket モードには URI が必要です\n\n使い方: tcping --ws [オプション] <ws://...|wss://...>\n詳細は 'tcping -h' を参照してください"
+}
+
+func (j *JapaneseLang) ErrorWSURI() string {
+	return "WebSocket の URI は ws:// または wss:// で始まる必要があります: %s"
+}
+
+func (j *JapaneseLang) ErrorWSHandshakeStatus() string {
+	return "WebSocket ハンドシェイクが拒否されました: %s"
+}
+
+func (j *JapaneseLang) ErrorWSHandshakeInvalid() string {
+	return "WebSocket ハンドシェイクの応答が不正です"
+}
+
+func (j *JapaneseLang) ErrorWSProtocol() string {
+	return "WebSocket プロトコルエラー: 制御フレームが大きすぎます"
+}
+
+func (j *JapaneseLang) ErrorWSClosed() string {
+	return "サーバーが接続を閉じました (コード %d %s)"
+}
+
+func (j *JapaneseLang) ErrorWSPongTimeout() string {
+	return "%dms 以内に pong がありません"
+}
+
+func (j *JapaneseLang) MsgWSPingStart() string {
+	return "%s に WebSocket ping を実行中\n"
+}
+
+func (j *JapaneseLang) MsgWSFailed() string {
+	return "WebSocket %s: seq=%d 失敗: %v\n"
+}
+
+func (j *JapaneseLang) MsgVerboseWSHandshake() string {
+	return "  ハンドシェイク: 合計 = %.2fms, 接続 = %.2fms, TLS ハンドシェイク = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgWSStatisticsTitle() string {
+	return "\n\n--- WebSocket ping 統計 ---\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsWSHandshake() string {
+	return "ハンドシェイク: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsWSReconnects() string {
+	return "再接続: %d\n"
+}
+
+// gRPC
+func (j *JapaneseLang) OptGRPC() string {
+	return "gRPC モード: host:port の grpc.health.v1.Health/Check を呼び出し、SERVING を成功とする"
+}
+
+func (j *JapaneseLang) OptGRPCService() string {
+	return "gRPC モードで確認するサービス名 (デフォルト: サーバー全体)"
+}
+
+func (j *JapaneseLang) ErrorRequiresGRPC() string {
+	return "%s には --grpc が必要です"
+}
+
+func (j *JapaneseLang) ErrorNotWithGRPC() string {
+	return "%s は --grpc と併用できません"
+}
+
+func (j *JapaneseLang) ErrorGRPCRequiresTarget() string {
+	return "gRPC モードにはターゲットが必要です\n\n使い方: tcping --grpc [オプション] <host:port>\n詳細は 'tcping -h' を参照してください"
+}
+
+func (j *JapaneseLang) ErrorGRPCTarget() string {
+	return "gRPC のターゲットが不正です (host:port 形式): %s"
+}
+
+func (j *JapaneseLang) ErrorGRPCResponse() string {
+	return "ヘルスチェックの応答が不正です"
+}
+
+func (j *JapaneseLang) ErrorGRPCStatus() string {
+	return "grpc-status %s (%s): %s"
+}
+
+func (j *JapaneseLang) ErrorGRPCHTTPStatus() string {
+	return "予期しない HTTP ステータス %s"
+}
+
+func (j *JapaneseLang) MsgGRPCPingStart() string {
+	return "%s に gRPC ヘルスチェックを実行中\n"
+}
+
+func (j *JapaneseLang) MsgGRPCService() string {
+	return "サービス: %s\n"
+}
+
+func (j *JapaneseLang) MsgGRPCFailed() string {
+	return "gRPC %s: seq=%d 失敗: %v\n"
+}
+
+func (j *JapaneseLang) MsgGRPCStatisticsTitle() string {
+	return "\n\n--- gRPC ヘルスチェック統計 ---\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsGRPCStatus() string {
+	return "ステータス %s: %d\n"
+}
+
+// Application protocols
+func (j *JapaneseLang) OptProto() string {
+	return "接続後にプロトコルのハンドシェイクを行う: redis, mysql, postgres, memcached, mongodb, smtp, ssh, ftp"
+}
+
+func (j *JapaneseLang) ErrorProtoTCPOnly() string {
+	return "--proto は TCP モードでのみ使用できます"
+}
+
+func (j *JapaneseLang) ErrorProtoUnexpected() string {
+	return "予期しない応答: %s"
+}
+
+func (j *JapaneseLang) MsgProtoFailed() string {
+	return "%s: seq=%d %.2fms で接続しましたが %s ハンドシェイクに失敗: %v\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsProto() string {
+	return "アプリケーションハンドシェイク: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// DNS
+func (j *JapaneseLang) OptDNS() string {
+	return "DNS モード: ターゲットサーバーに DNS クエリを送信 (デフォルトポート 53、dot は 853)"
+}
+
+func (j *JapaneseLang) OptDNSName() string {
+	return "DNS モードで問い合わせる名前 (デフォルト: .)"
+}
+
+func (j *JapaneseLang) OptDNSType() string {
+	return "問い合わせるレコードタイプ (例: A, AAAA, MX、デフォルト: NS)"
+}
+
+func (j *JapaneseLang) OptDNSTransport() string {
+	return "DNS のトランスポート: udp、tcp、dot (DNS over TLS) (デフォルト: udp)"
+}
+
+func (j *JapaneseLang) ErrorNotWithDNS() string {
+	return "%s は --dns と併用できません"
+}
+
+func (j *JapaneseLang) ErrorDNSName() string {
+	return "DNS 名が不正です: %s"
+}
+
+func (j *JapaneseLang) ErrorDNSReply() string {
+	return "DNS の応答が不正です"
+}
+
+func (j *JapaneseLang) MsgDNSPingStart() string {
+	return "%s (%s - %s) ポート %s に DNS クエリを実行中: %s %s (%s)\n"
+}
+
+func (j *JapaneseLang) MsgDNSFailed() string {
+	return "DNS %s: seq=%d 失敗: %v\n"
+}
+
+func (j *JapaneseLang) MsgVerboseDNSConn() string {
+	return "  接続: 接続 = %.2fms, TLS ハンドシェイク = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgDNSStatisticsTitle() string {
+	return "\n\n--- DNS クエリ統計 ---\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsDNSRcode() string {
+	return "応答コード %s: %d\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsDNSTruncated() string {
+	return "切り詰められた応答: %d\n"
+}
+
+// ICMP
+func (j *JapaneseLang) OptICMP() string {
+	return "非特権 ping ソケットによる ICMP エコーモード (Linux)"
+}
+
+func (j *JapaneseLang) OptCompareICMP() string {
+	return "各 TCP プローブの後に ICMP エコーを送信し RTT を比較"
+}
+
+func (j *JapaneseLang) ErrorICMPNotPermitted() string {
+	return "このユーザーには ping ソケットが許可されていません。次のコマンドでグループを許可してください: sysctl -w net.ipv4.ping_group_range=\"0 2147483647\""
+}
+
+func (j *JapaneseLang) ErrorICMPUnsupported() string {
+	return "--icmp には Linux の非特権 ping ソケットが必要で、このプラットフォームではサポートされていません"
+}
+
+func (j *JapaneseLang) ErrorICMPPort() string {
+	return "--icmp ではポートを指定できません"
+}
+
+func (j *JapaneseLang) ErrorICMPAddress() string {
+	return "無効な ICMP ターゲットアドレス: %s"
+}
+
+func (j *JapaneseLang) ErrorICMPTimeout() string {
+	return "%dms 以内にエコー応答がありません"
+}
+
+func (j *JapaneseLang) ErrorNotWithICMP() string {
+	return "%s は --icmp または --compare-icmp と併用できません"
+}
+
+func (j *JapaneseLang) MsgICMPPingStart() string {
+	return "%s (%s - %s) に ICMP エコーで Ping を実行しています\n"
+}
+
+func (j *JapaneseLang) MsgICMPCompareUnavailable() string {
+	return "ICMP 比較を無効にしました: %v\n"
+}
+
+func (j *JapaneseLang) MsgICMPFailed() string {
+	return "ICMP %s: seq=%d 失敗: %v\n"
+}
+
+func (j *JapaneseLang) MsgICMPStatisticsTitle() string {
+	return "\n\n--- ICMP Ping 統計 ---\n"
+}
+
+func (j *JapaneseLang) MsgICMPCompareTitle() string {
+	return "\nTCP と ICMP の比較:\n"
+}
+
+func (j *JapaneseLang) MsgICMPCompareRow() string {
+	return "  %-4s 送信 = %d, 受信 = %d (%.1f%% 損失), 最小/平均/最大 = %.2f/%.2f/%.2fms\n"
+}
+
+func (j *JapaneseLang) MsgICMPCompareDiff() string {
+	return "  平均 TCP - ICMP = %+.2fms\n"
+}
+
+func (j *JapaneseLang) ErrorICMPUnixTarget() string {
+	return "--icmp と --compare-icmp は unix: ターゲットでは使用できません"
+}
+
+// NTP
+func (j *JapaneseLang) OptNTP() string {
+	return "NTP モード: SNTP リクエストを送信し時刻のずれを報告 (デフォルトポート 123)"
+}
+
+func (j *JapaneseLang) ErrorNotWithNTP() string {
+	return "%s は --ntp と併用できません"
+}
+
+func (j *JapaneseLang) ErrorNTPKissCode() string {
+	return "サーバーがリクエストを拒否しました (キスコード %s)"
+}
+
+func (j *JapaneseLang) ErrorNTPUnsynchronized() string {
+	return "サーバーの時計が同期されていません"
+}
+
+func (j *JapaneseLang) ErrorNTPReply() string {
+	return "無効な NTP 応答"
+}
+
+func (j *JapaneseLang) MsgNTPPingStart() string {
+	return "NTP サーバー %s (%s - %s) ポート %s に問い合わせています\n"
+}
+
+func (j *JapaneseLang) MsgNTPFailed() string {
+	return "NTP %s: seq=%d 失敗: %v\n"
+}
+
+func (j *JapaneseLang) MsgVerboseNTP() string {
+	return "  サーバー: うるう秒指示 = %d, ルート遅延 = %.2fms, ルート分散 = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgNTPStatisticsTitle() string {
+	return "\n\n--- NTP クエリ統計 ---\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsNTPOffset() string {
+	return "時刻のずれ: 最小 = %+.3fms, 最大 = %+.3fms, 平均 = %+.3fms\n"
+}
+
+// Persistent connection
+func (j *JapaneseLang) OptPersistent() string {
+	return "1 本の TCP 接続を維持し、その上で要求/応答の往復時間を測定"
+}
+
+func (j *JapaneseLang) OptSend() string {
+	return "各プローブで送信するデータ (\\r\\n などのエスケープ可、デフォルト: エコー行)"
+}
+
+func (j *JapaneseLang) OptExpect() string {
+	return "応答に含まれるべきデータ (デフォルト: 任意の応答)"
+}
+
+func (j *JapaneseLang) ErrorRequiresPersistent() string {
+	return "%s には --persistent または --find-idle-timeout が必要です"
+}
+
+func (j *JapaneseLang) ErrorExpectRequiresSend() string {
+	return "--expect には --send が必要です"
+}
+
+func (j *JapaneseLang) ErrorNotWithPersistent() string {
+	return "%s は --persistent と併用できません"
+}
+
+func (j *JapaneseLang) ErrorPersistentTimeout() string {
+	return "%dms 以内に応答がありません"
+}
+
+func (j *JapaneseLang) MsgPersistentPingStart() string {
+	return "%s への持続接続を維持しています\n"
+}
+
+func (j *JapaneseLang) MsgPersistentConnectFailed() string {
+	return "TCP %s: seq=%d 接続失敗: %v\n"
+}
+
+func (j *JapaneseLang) MsgPersistentDropped() string {
+	return "TCP %s: seq=%d 接続が切断されました: %v (接続時間 %v, アイドル %v)\n"
+}
+
+func (j *JapaneseLang) MsgPersistentStatisticsTitle() string {
+	return "\n\n--- 持続接続統計 ---\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsPersistentConnect() string {
+	return "接続: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsPersistentDrops() string {
+	return "切断: %d, 再接続: %d\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsPersistentLifetime() string {
+	return "切断された接続の存続時間: 最小 = %.1fs, 最大 = %.1fs, 平均 = %.1fs\n"
+}
+
+// Idle timeout discovery
+func (j *JapaneseLang) OptFindIdleTimeout() string {
+	return "経路上でアイドル接続が維持される時間を探索 (NAT/ファイアウォールのタイムアウト)"
+}
+
+func (j *JapaneseLang) OptIdleMin() string {
+	return "テストする最短アイドル時間と探索精度 (デフォルト: 1s)"
+}
+
+func (j *JapaneseLang) OptIdleMax() string {
+	return "テストする最長アイドル時間 (デフォルト: 10m)"
+}
+
+func (j *JapaneseLang) ErrorNotWithFindIdle() string {
+	return "%s は --find-idle-timeout と併用できません"
+}
+
+func (j *JapaneseLang) ErrorIdleRange() string {
+	return "--idle-min は 0 より大きく --idle-max 以下である必要があります"
+}
+
+func (j *JapaneseLang) MsgIdleSearchStart() string {
+	return "%s のアイドルタイムアウトを %v から %v の範囲で探索しています\n"
+}
+
+func (j *JapaneseLang) MsgIdleWaiting() string {
+	return "seq=%d: 新しい接続を %v アイドル状態に保持しています\n"
+}
+
+func (j *JapaneseLang) MsgIdleAlive() string {
+	return "TCP %s: seq=%d アイドル=%v 存続 time=%.2fms\n"
+}
+
+func (j *JapaneseLang) MsgIdleDropped() string {
+	return "TCP %s: seq=%d アイドル=%v 切断: %v\n"
+}
+
+func (j *JapaneseLang) MsgIdleSetupFailed() string {
+	return "TCP %s: seq=%d ターゲットが利用できません: %v\n"
+}
+
+func (j *JapaneseLang) MsgIdleStatisticsTitle() string {
+	return "\n\n--- アイドルタイムアウト ---\n"
+}
+
+func (j *JapaneseLang) MsgIdleRange() string {
+	return "アイドル接続は %v より長く %v 以内で切断されます\n"
+}
+
+func (j *JapaneseLang) MsgIdleBelow() string {
+	return "アイドル接続は %v 以内に切断されます\n"
+}
+
+func (j *JapaneseLang) MsgIdleNone() string {
+	return "%v まではアイドルタイムアウトがありません\n"
+}
+
+func (j *JapaneseLang) MsgIdleAtLeast() string {
+	return "アイドル接続は少なくとも %v 維持されます (探索未完了)\n"
+}
+
+func (j *JapaneseLang) MsgIdleAborted() string {
+	return "探索を中止しました: %v\n"
+}
+
+func (j *JapaneseLang) MsgIdleChecks() string {
+	return "チェック: %d, ターゲット利用不可: %d\n"
+}
+
+// Load mode
+func (j *JapaneseLang) OptRate() string {
+	return "前のプローブを待たずに一定のレートでプローブを開始 (例: 100/s, 600/m)"
+}
+
+func (j *JapaneseLang) OptConcurrency() string {
+	return "同時に実行するプローブの上限。--rate なしではこの数のプローブを連続実行"
+}
+
+func (j *JapaneseLang) ErrorNotWithLoad() string {
+	return "%s は --rate または --concurrency と併用できません"
+}
+
+func (j *JapaneseLang) MsgLoadStart() string {
+	return "負荷モード: %.1f プローブ/秒, 同時実行は最大 %d\n"
+}
+
+func (j *JapaneseLang) MsgLoadStartUnlimited() string {
+	return "負荷モード: レート制限なし, 同時実行 %d\n"
+}
+
+func (j *JapaneseLang) MsgLoadTitle() string {
+	return "\n--- 負荷 ---\n"
+}
+
+func (j *JapaneseLang) MsgLoadTarget() string {
+	return "目標: %.1f プローブ/秒, 同時実行 %d\n"
+}
+
+func (j *JapaneseLang) MsgLoadUnlimited() string {
+	return "目標: レート制限なし, 同時実行 %d\n"
+}
+
+func (j *JapaneseLang) MsgLoadAchieved() string {
+	return "開始: %d 回 / %.1f 秒, 実測 %.1f プローブ/秒, 同時実行上限でスキップ: %d\n"
+}
+
+func (j *JapaneseLang) MsgLoadLatency() string {
+	return "遅延: p50 %.2fms, p90 %.2fms, p99 %.2fms, p99.9 %.2fms, 最大 %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgLoadErrors() string {
+	return "エラー: %s\n"
+}
+
+// Fixed-cadence scheduling
+func (j *JapaneseLang) OptIntervalJitter() string {
+	return "各プローブをこの値までのランダムな時間だけ遅らせる (例: 200ms, 間隔以下)"
+}
+
+func (j *JapaneseLang) OptMaxInFlight() string {
+	return "プローブが間隔より長くかかる場合の同時実行の上限 (デフォルト: タイムアウト / 間隔)"
+}
+
+func (j *JapaneseLang) ErrorJitterRange() string {
+	return "--interval-jitter は間隔より大きくできません"
+}
+
+func (j *JapaneseLang) ErrorNotWithMaxInFlight() string {
+	return "%s は逐次プローブするため --max-inflight と併用できません"
+}
+
+func (j *JapaneseLang) MsgScheduleSkipped() string {
+	return "%d 回のプローブ時刻をスキップしました: %d 件のプローブが実行中\n"
+}
+
+func (j *JapaneseLang) MsgScheduleLate() string {
+	return "seq=%d 予定より %v 遅れて送信 (同時実行上限: %d)\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsSchedule() string {
+	return "スケジュール: 遅延送信 %d 回, スキップ %d 回\n"
+}
+
+// Adaptive timeout and interval
+func (j *JapaneseLang) OptAdaptive() string {
+	return "観測した RTT からタイムアウトを決め、失敗後はプローブ間隔を短縮"
+}
+
+func (j *JapaneseLang) MsgVerboseAdaptive() string {
+	return "  適応: seq=%d タイムアウト=%v 間隔=%v srtt=%v rttvar=%v\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsAdaptiveTimeout() string {
+	return "適応タイムアウト: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+}
+
+func (j *JapaneseLang) MsgStatisticsAdaptiveInterval() string {
+	return "適応間隔: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+}
+
+// Recording and analyze
+func (j *JapaneseLang) UsageAnalyze() string {
+	return "tcping analyze [オプション] <ファイル>...       # 記録した結果を分析"
+}
+
+func (j *JapaneseLang) OptRecord() string {
+	return "すべてのプローブ結果を JSON Lines ファイルに追記 (tcping analyze 用)"
+}
+
+func (j *JapaneseLang) OptAnalyzeFrom() string {
+	return "この時刻以降の記録のみ分析"
+}
+
+func (j *JapaneseLang) OptAnalyzeTo() string {
+	return "この時刻より前の記録のみ分析"
+}
+
+func (j *JapaneseLang) OptAnalyzeMerge() string {
+	return "すべてのファイルから同じターゲットの記録を統合"
+}
+
+func (j *JapaneseLang) OptAnalyzeJSON() string {
+	return "分析結果を JSON で出力"
+}
+
+func (j *JapaneseLang) ErrorRecordOpen() string {
+	return "記録ファイル %s を開けません: %v"
+}
+
+func (j *JapaneseLang) ErrorRecordWrite() string {
+	return "記録ファイル %s への書き込みに失敗しました: %v"
+}
+
+func (j *JapaneseLang) ErrorRecordParse() string {
+	return "%s:%d: 無効な記録: %v"
+}
+
+func (j *JapaneseLang) ErrorRecordTime() string {
+	return "無効な時刻 %q です。RFC 3339 または 2006-01-02 15:04:05 形式を使用してください"
+}
+
+func (j *JapaneseLang) ErrorAnalyzeNoFiles() string {
+	return "tcping analyze には記録ファイルが 1 つ以上必要です"
+}
+
+func (j *JapaneseLang) ErrorAnalyzeNoRecords() string {
+	return "指定した時間範囲に記録がありません"
+}
+
+func (j *JapaneseLang) MsgAnalyzeTitle() string {
+	return "\n--- %s ---\n"
+}
+
+func (j *JapaneseLang) MsgAnalyzeFiles() string {
+	return "ファイル: %s\n"
+}
+
+func (j *JapaneseLang) MsgAnalyzeRange() string {
+	return "記録: %d 件, %s - %s (%v)\n"
+}
+
+func (j *JapaneseLang) MsgAnalyzePercentiles() string {
+	return "パーセンタイル: P50 = %.2fms, P90 = %.2fms, P99 = %.2fms, P99.9 = %.2fms\n"
+}
+
+func (j *JapaneseLang) MsgAnalyzeOutages() string {
+	return "中断: %d 回\n"
+}
+
+func (j *JapaneseLang) MsgAnalyzeOutageOpen() string {
+	return "記録終了"
+}
+
+func (j *JapaneseLang) MsgAnalyzeCompareTitle() string {
+	return "\n比較:\n"
+}
+
+func (j *JapaneseLang) MsgAnalyzeCompareRow() string {
+	return "  %s: 送信 = %d, 損失 %.1f%%, P50/P99 = %.2f/%.2fms, 中断 = %d\n"
+}
+
+// Dashboard conflicts
+func (j *JapaneseLang) ErrorNotWithTUI() string {
+	return "%s は --tui と併用できません"
+}
+
+// Idle timeout search: inconclusive checks
+func (j *JapaneseLang) MsgIdleTimedOut() string {
+	return "TCP %s: seq=%d アイドル=%v 判定不能: %v\n"
+}
+
+func (j *JapaneseLang) MsgIdleInconclusive() string {
+	return "アイドル %v 後の応答がタイムアウトし、リセットやクローズもないため探索を停止しました。-w を大きくすると応答の遅延と無通知の切断を区別できます\n"
 }
\ No newline at end of file
@@ -299,11 +299,11 @@ func (k *KoreanLang) MsgStatisticsRTT() string {
 }
 
 func (k *KoreanLang) MsgStatisticsTotalData() string {
-	return "총 전송 데이터: %d bytes (%.2f MB)\n"
+	return "총 수신 데이터 (회선 기준): %d bytes (%.2f MB)\n"
 }
 
 func (k *KoreanLang) MsgStatisticsBandwidth() string {
-	return "추정 대역폭: 최소 = %.2f Mbps, 최대 = %.2f Mbps, 평균 = %.2f Mbps\n"
+	return "굿풋 (본문 / 총 시간): 최소 = %.2f Mbps, 최대 = %.2f Mbps, 평균 = %.2f Mbps\n"
 }
 
 // IP type strings
@@ -313,4 +313,1103 @@ func (k *KoreanLang) IPv4String() string {
 
 func (k *KoreanLang) IPv6String() string {
 	return "IPv6"
+}
+
+// Argument parsing
+func (k *KoreanLang) ErrorUnknownOption() string {
+	return "알 수 없는 옵션: %s"
+}
+
+func (k *KoreanLang) ErrorOptionRequiresValue() string {
+	return "옵션 %s 에는 값이 필요합니다"
+}
+
+func (k *KoreanLang) ErrorInvalidOptionValue() string {
+	return "옵션 %s 의 값이 잘못되었습니다: %s"
+}
+
+func (k *KoreanLang) ErrorOptionTakesNoValue() string {
+	return "옵션 %s 는 값을 받지 않습니다"
+}
+
+func (k *KoreanLang) ErrorTryHelp() string {
+	return "자세한 내용은 'tcping -h' 를 참조하세요"
+}
+
+// Live dashboard
+func (k *KoreanLang) OptTUI() string {
+	return "RTT 스파크라인이 포함된 전체 화면 실시간 대시보드"
+}
+
+func (k *KoreanLang) MsgTUIUnsupported() string {
+	return "터미널이 실시간 대시보드를 지원하지 않아 줄 단위 출력으로 전환합니다\n"
+}
+
+func (k *KoreanLang) MsgTUITitle() string {
+	return "%s 실시간 대시보드 - %s"
+}
+
+func (k *KoreanLang) MsgTUIElapsed() string {
+	return "경과 시간 %s, 전송한 프로브 %d"
+}
+
+func (k *KoreanLang) MsgTUIRTT() string {
+	return "RTT"
+}
+
+func (k *KoreanLang) MsgTUILastRTT() string {
+	return "최근 %.2fms"
+}
+
+func (k *KoreanLang) MsgTUILoss() string {
+	return "손실"
+}
+
+func (k *KoreanLang) MsgTUILossDetail() string {
+	return "%.1f%% (%d/%d 손실)"
+}
+
+func (k *KoreanLang) MsgTUIStatsLabel() string {
+	return "통계"
+}
+
+func (k *KoreanLang) MsgTUIStats() string {
+	return "최소 %.2fms  평균 %.2fms  최대 %.2fms  p50 %.2fms  p90 %.2fms  p99 %.2fms"
+}
+
+func (k *KoreanLang) MsgTUILastError() string {
+	return "최근 오류"
+}
+
+func (k *KoreanLang) MsgTUINone() string {
+	return "없음"
+}
+
+func (k *KoreanLang) MsgTUIOutages() string {
+	return "중단 기록"
+}
+
+func (k *KoreanLang) MsgTUIOutageEntry() string {
+	return "%s - %s  실패한 프로브 %d회  %s"
+}
+
+func (k *KoreanLang) MsgTUIOutageOngoing() string {
+	return "진행 중"
+}
+
+func (k *KoreanLang) MsgTUIHint() string {
+	return "중지하려면 Ctrl-C 를 누르세요"
+}
+
+// Interim statistics
+func (k *KoreanLang) OptSummaryEvery() string {
+	return "중간 통계를 주기적으로 출력 (예: 60s), SIGQUIT/SIGUSR1 로 즉시 출력"
+}
+
+func (k *KoreanLang) OptSummaryWindow() string {
+	return "중간 통계에 최근 구간 통계도 포함"
+}
+
+func (k *KoreanLang) MsgInterimTitle() string {
+	return "\n--- 중간 통계 %s (경과 %s) ---\n"
+}
+
+func (k *KoreanLang) MsgInterimWindow() string {
+	return "최근 %s:\n"
+}
+
+// Stop conditions
+func (k *KoreanLang) OptDuration() string {
+	return "지정한 시간이 지나면 중지 (예: 10m)"
+}
+
+func (k *KoreanLang) OptUntilSuccess() string {
+	return "첫 번째 성공 시 중지 (포트가 열릴 때까지 대기)"
+}
+
+func (k *KoreanLang) OptUntilFailure() string {
+	return "첫 번째 실패 시 중지"
+}
+
+func (k *KoreanLang) OptMaxFailures() string {
+	return "실패 횟수가 누적으로 이 값에 도달하면 중지"
+}
+
+func (k *KoreanLang) MsgStopDuration() string {
+	return "\n중지: 실행 시간 %s 에 도달했습니다\n"
+}
+
+func (k *KoreanLang) MsgStopUntilSuccess() string {
+	return "\n중지: 대상이 응답했습니다 (--until-success)\n"
+}
+
+func (k *KoreanLang) MsgStopUntilFailure() string {
+	return "\n중지: 프로브가 실패했습니다 (--until-failure)\n"
+}
+
+func (k *KoreanLang) MsgStopMaxFailures() string {
+	return "\n중지: 실패 %d 회에 도달했습니다 (--max-failures)\n"
+}
+
+// Thresholds and verdict
+func (k *KoreanLang) OptMaxLoss() string {
+	return "손실률이 이 비율을 초과하면 실패로 판정 (예: 5%)"
+}
+
+func (k *KoreanLang) OptMaxAvgRTT() string {
+	return "평균 RTT 가 이 시간을 초과하면 실패로 판정 (예: 50ms)"
+}
+
+func (k *KoreanLang) OptMaxP99RTT() string {
+	return "p99 RTT 가 이 시간을 초과하면 실패로 판정"
+}
+
+func (k *KoreanLang) OptJSON() string {
+	return "최종 통계와 판정을 JSON 으로 출력"
+}
+
+func (k *KoreanLang) MsgThresholdLoss() string {
+	return "손실률 %.1f%% 가 한도 %.1f%% 를 초과했습니다"
+}
+
+func (k *KoreanLang) MsgThresholdAvgRTT() string {
+	return "평균 RTT %.2fms 가 한도 %.2fms 를 초과했습니다"
+}
+
+func (k *KoreanLang) MsgThresholdP99RTT() string {
+	return "p99 RTT %.2fms 가 한도 %.2fms 를 초과했습니다"
+}
+
+func (k *KoreanLang) MsgThresholdNoResponse() string {
+	return "성공한 응답이 없습니다"
+}
+
+func (k *KoreanLang) MsgVerdictPass() string {
+	return "판정: 통과\n"
+}
+
+func (k *KoreanLang) MsgVerdictFail() string {
+	return "판정: 실패 (%s)\n"
+}
+
+// HTTP transfer
+func (k *KoreanLang) MsgStatisticsBodyData() string {
+	return "응답 본문 (압축 해제 후): %d bytes (%.2f MB)\n"
+}
+
+func (k *KoreanLang) MsgVerboseHTTPTransfer() string {
+	return "    타이밍: 헤더 = %.2fms, 본문 = %.2fms, 합계 = %.2fms\n"
+}
+
+// Throughput test
+func (k *KoreanLang) OptThroughput() string {
+	return "상태 확인 요청 대신 HTTP 지속 처리량을 측정"
+}
+
+func (k *KoreanLang) OptUploadSize() string {
+	return "다운로드 대신 지정한 크기 (예: 10M)의 생성 데이터를 업로드"
+}
+
+func (k *KoreanLang) OptStreams() string {
+	return "병렬 처리량 스트림 수 (기본값 1)"
+}
+
+func (k *KoreanLang) MsgThroughputDownload() string {
+	return "다운로드"
+}
+
+func (k *KoreanLang) MsgThroughputUpload() string {
+	return "업로드"
+}
+
+func (k *KoreanLang) MsgThroughputStart() string {
+	return "처리량 테스트 (%s, 스트림 %d개), %s마다 샘플링\n"
+}
+
+func (k *KoreanLang) MsgThroughputSample() string {
+	return "  %7.2fs  %10.2f Mbps  (%d bytes)\n"
+}
+
+func (k *KoreanLang) MsgThroughputStreamFailed() string {
+	return "  스트림 %d 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgThroughputStreamDone() string {
+	return "  스트림 %d: %d bytes / %s\n"
+}
+
+func (k *KoreanLang) MsgThroughputStatisticsTitle() string {
+	return "\n--- HTTP 처리량 통계 ---\n"
+}
+
+func (k *KoreanLang) MsgThroughputStreams() string {
+	return "스트림 = %d, 완료 = %d, 실패 = %d\n"
+}
+
+func (k *KoreanLang) MsgThroughputTransferred() string {
+	return "전송량 (%s): %d bytes (%.2f MB), 소요 시간 %s\n"
+}
+
+func (k *KoreanLang) MsgThroughputRampUp() string {
+	return "램프업 시간: %s\n"
+}
+
+func (k *KoreanLang) MsgThroughputSustained() string {
+	return "지속 처리량: 최소 = %.2f Mbps, 최대 = %.2f Mbps, 평균 = %.2f Mbps\n"
+}
+
+func (k *KoreanLang) MsgThroughputFinal() string {
+	return "최종 처리량: %.2f Mbps\n"
+}
+
+func (k *KoreanLang) MsgThroughputTTFB() string {
+	return "첫 바이트까지 시간: 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+// HTTP connection reuse
+func (k *KoreanLang) OptHTTPReuse() string {
+	return "HTTP 프로브 간 keep-alive 연결 재사용 (기본값)"
+}
+
+func (k *KoreanLang) OptHTTPFresh() string {
+	return "HTTP 프로브마다 새 TCP/TLS 연결을 생성"
+}
+
+func (k *KoreanLang) ErrorRequiresHTTPMode() string {
+	return "%s 옵션은 HTTP 모드 (-H)가 필요합니다"
+}
+
+func (k *KoreanLang) ErrorHTTPReuseFresh() string {
+	return "--http-reuse와 --http-fresh는 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) MsgVerboseHTTPConnReused() string {
+	return "    연결: 재사용, 로컬 포트 %d, 유휴 시간 %s\n"
+}
+
+func (k *KoreanLang) MsgVerboseHTTPConnNew() string {
+	return "    연결: 신규, 로컬 포트 %d, 연결 = %.2fms, TLS 핸드셰이크 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgStatisticsReusedConns() string {
+	return "재사용 연결: %d, 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgStatisticsNewConns() string {
+	return "신규 연결: %d, 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+// HTTP protocol version
+func (k *KoreanLang) OptHTTP2() string {
+	return "HTTP/2 강제 (https는 ALPN으로 h2, http는 h2c prior knowledge)"
+}
+
+func (k *KoreanLang) OptHTTP3() string {
+	return "QUIC 기반 HTTP/3 사용 (https 전용)"
+}
+
+func (k *KoreanLang) ErrorHTTP2HTTP3() string {
+	return "--http2와 --http3는 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorHTTP3RequiresHTTPS() string {
+	return "--http3는 https:// URI가 필요합니다"
+}
+
+// HTTP redirects
+func (k *KoreanLang) OptFollow() string {
+	return "HTTP 리디렉션을 따라가 최종 응답을 표시"
+}
+
+func (k *KoreanLang) OptMaxRedirs() string {
+	return "따라갈 최대 리디렉션 횟수 (기본값 10)"
+}
+
+func (k *KoreanLang) ErrorRedirectDowngrade() string {
+	return "https에서 http로 다운그레이드되는 리디렉션: %v -> %v"
+}
+
+func (k *KoreanLang) ErrorRedirectLoop() string {
+	return "리디렉션 루프 감지: %v"
+}
+
+func (k *KoreanLang) ErrorTooManyRedirects() string {
+	return "%d회 리디렉션 후 중지되었습니다"
+}
+
+func (k *KoreanLang) MsgVerboseHTTPRedirect() string {
+	return "    리디렉션 %d: %d %s -> %s (%.2fms)\n"
+}
+
+// TLS options
+func (k *KoreanLang) OptCACert() string {
+	return "시스템 루트 인증서 대신 이 PEM 파일의 CA 인증서를 신뢰"
+}
+
+func (k *KoreanLang) OptCert() string {
+	return "상호 TLS용 클라이언트 인증서 (PEM)"
+}
+
+func (k *KoreanLang) OptKey() string {
+	return "--cert의 개인 키 (PEM) (기본값: --cert 파일에서 읽음)"
+}
+
+func (k *KoreanLang) OptTLSMin() string {
+	return "최소 TLS 버전 (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (k *KoreanLang) OptTLSMax() string {
+	return "최대 TLS 버전 (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (k *KoreanLang) OptCiphers() string {
+	return "TLS 1.2 이하에서 허용할 암호 스위트 (쉼표로 구분)"
+}
+
+func (k *KoreanLang) OptSNI() string {
+	return "TLS 핸드셰이크에서 전송하고 인증서 검증에 사용할 서버 이름"
+}
+
+func (k *KoreanLang) ErrorTLSVersionRange() string {
+	return "--tls-min은 --tls-max보다 높을 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorTLSLoadCACert() string {
+	return "CA 인증서 파일 %s을(를) 읽을 수 없습니다: %w"
+}
+
+func (k *KoreanLang) ErrorTLSNoCACerts() string {
+	return "%s에서 PEM 인증서를 찾을 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorTLSKeyWithoutCert() string {
+	return "--key는 --cert가 필요합니다"
+}
+
+func (k *KoreanLang) ErrorTLSLoadClientCert() string {
+	return "클라이언트 인증서를 불러올 수 없습니다: %w"
+}
+
+func (k *KoreanLang) ErrorTLSUnknownAuthority() string {
+	return "알 수 없는 기관이 서명한 인증서입니다 (발급자: %s). --cacert로 신뢰할 수 있습니다"
+}
+
+func (k *KoreanLang) ErrorTLSHostname() string {
+	return "인증서가 %s에 유효하지 않습니다 (유효한 이름: %s)"
+}
+
+func (k *KoreanLang) ErrorTLSExpired() string {
+	return "인증서가 만료되었거나 아직 유효하지 않습니다 (유효 기간 %s ~ %s)"
+}
+
+func (k *KoreanLang) ErrorTLSInvalidCert() string {
+	return "인증서가 유효하지 않습니다: %v"
+}
+
+func (k *KoreanLang) ErrorTLSNotTLS() string {
+	return "서버가 TLS로 응답하지 않았습니다 (http:// 포트가 아닌지 확인하세요)"
+}
+
+func (k *KoreanLang) ErrorTLSRemoteAlert() string {
+	return "서버가 TLS 핸드셰이크를 거부했습니다: %v"
+}
+
+func (k *KoreanLang) MsgVerboseHTTPTLS() string {
+	return "    TLS: %s, %s, 서버 이름 %s\n"
+}
+
+// Proxy
+func (k *KoreanLang) OptProxy() string {
+	return "HTTP CONNECT 또는 SOCKS5 프록시를 통해 연결 (http://, socks5://, socks5h://, user:pass@ 지원)"
+}
+
+func (k *KoreanLang) OptProxyEnv() string {
+	return "HTTP_PROXY/HTTPS_PROXY의 프록시 사용 (NO_PROXY 준수)"
+}
+
+func (k *KoreanLang) ErrorProxyURL() string {
+	return "잘못된 프록시 주소: %s"
+}
+
+func (k *KoreanLang) ErrorProxyScheme() string {
+	return "지원하지 않는 프록시 스킴 %q (http, socks5, socks5h 사용)"
+}
+
+func (k *KoreanLang) ErrorProxyHTTP3() string {
+	return "--http3는 프록시를 통해 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorProxyDial() string {
+	return "프록시 %v에 연결할 수 없습니다: %v"
+}
+
+func (k *KoreanLang) ErrorProxyConnect() string {
+	return "프록시가 CONNECT를 거부했습니다: %s"
+}
+
+func (k *KoreanLang) ErrorSOCKSProtocol() string {
+	return "프록시의 SOCKS5 응답이 잘못되었습니다"
+}
+
+func (k *KoreanLang) ErrorSOCKSNoMethod() string {
+	return "SOCKS5 프록시가 지원하지 않는 인증 방식을 요구합니다"
+}
+
+func (k *KoreanLang) ErrorSOCKSAuth() string {
+	return "SOCKS5 프록시 인증에 실패했습니다"
+}
+
+func (k *KoreanLang) ErrorSOCKSReply() string {
+	return "SOCKS5 프록시가 대상에 연결하지 못했습니다: %s"
+}
+
+func (k *KoreanLang) MsgViaProxy() string {
+	return "프록시 %s 사용\n"
+}
+
+func (k *KoreanLang) MsgTCPPingStartProxy() string {
+	return "%s 포트 %s에 TCP Ping 실행 중 (주소는 프록시가 확인)\n"
+}
+
+func (k *KoreanLang) MsgVerboseHTTPProxy() string {
+	return "    프록시: %s, 프록시 연결 = %.2fms, 터널 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgStatisticsProxyConnect() string {
+	return "프록시 연결: %d, 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgStatisticsProxyTunnel() string {
+	return "대상으로의 터널: %d, 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+// Unix domain sockets
+func (k *KoreanLang) OptUnixSocket() string {
+	return "이 Unix 도메인 소켓으로 HTTP 프로브 전송 (URI는 경로와 Host에만 사용)"
+}
+
+func (k *KoreanLang) ErrorUnixSocketConflict() string {
+	return "Unix 도메인 소켓은 --proxy 또는 --http3와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorUnixTargetPort() string {
+	return "unix: 대상에는 포트를 지정할 수 없습니다"
+}
+
+func (k *KoreanLang) MsgUnixPingStart() string {
+	return "Unix 도메인 소켓 %s에 연결 중\n"
+}
+
+func (k *KoreanLang) MsgViaUnixSocket() string {
+	return "Unix 도메인 소켓 %s 사용\n"
+}
+
+// WebSocket
+func (k *KoreanLang) OptWebSocket() string {
+	return "WebSocket 모드: ws:// 또는 wss:// URI와 핸드셰이크 후 ping/pong 왕복 시간 측정"
+}
+
+func (k *KoreanLang) ErrorNotWithWebSocket() string {
+	return "%s는 --ws와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorWSRequiresURI() string {
+	return "WebSocket 모드에는 URI가 필요합니다\n\n사용법: tcping --ws [옵션] <ws://...|wss://...>\n자세한 내용은 'tcping -h'를 참조하세요"
+}
+
+func (k *KoreanLang) ErrorWSURI() string {
+	return "WebSocket URI는 ws:// 또는 wss://로 시작해야 합니다: %s"
+}
+
+func (k *KoreanLang) ErrorWSHandshakeStatus() string {
+	return "WebSocket 핸드셰이크가 거부되었습니다: %s"
+}
+
+func (k *KoreanLang) ErrorWSHandshakeInvalid() string {
+	return "WebSocket 핸드셰이크 응답이 잘못되었습니다"
+}
+
+func (k *KoreanLang) ErrorWSProtocol() string {
+	return "WebSocket 프로토콜 오류: 제어 프레임이 너무 큽니다"
+}
+
+func (k *KoreanLang) ErrorWSClosed() string {
+	return "서버가 연결을 닫았습니다 (코드 %d %s)"
+}
+
+func (k *KoreanLang) ErrorWSPongTimeout() string {
+	return "%dms 내에 pong이 없습니다"
+}
+
+func (k *KoreanLang) MsgWSPingStart() string {
+	return "%s에 WebSocket ping 실행 중\n"
+}
+
+func (k *KoreanLang) MsgWSFailed() string {
+	return "WebSocket %s: seq=%d 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgVerboseWSHandshake() string {
+	return "  핸드셰이크: 전체 = %.2fms, 연결 = %.2fms, TLS 핸드셰이크 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgWSStatisticsTitle() string {
+	return "\n\n--- WebSocket ping 통계 ---\n"
+}
+
+func (k *KoreanLang) MsgStatisticsWSHandshake() string {
+	return "핸드셰이크: %d, 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgStatisticsWSReconnects() string {
+	return "재연결: %d\n"
+}
+
+// gRPC
+func (k *KoreanLang) OptGRPC() string {
+	return "gRPC 모드: host:port의 grpc.health.v1.Health/Check를 호출하고 SERVING을 성공으로 처리"
+}
+
+func (k *KoreanLang) OptGRPCService() string {
+	return "gRPC 모드에서 확인할 서비스 이름 (기본값: 서버 전체)"
+}
+
+func (k *KoreanLang) ErrorRequiresGRPC() string {
+	return "%s는 --grpc가 필요합니다"
+}
+
+func (k *KoreanLang) ErrorNotWithGRPC() string {
+	return "%s는 --grpc와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorGRPCRequiresTarget() string {
+	return "gRPC 모드에는 대상이 필요합니다\n\n사용법: tcping --grpc [옵션] <host:port>\n자세한 내용은 'tcping -h'를 참조하세요"
+}
+
+func (k *KoreanLang) ErrorGRPCTarget() string {
+	return "잘못된 gRPC 대상입니다 (host:port 형식): %s"
+}
+
+func (k *KoreanLang) ErrorGRPCResponse() string {
+	return "헬스 체크 응답이 잘못되었습니다"
+}
+
+func (k *KoreanLang) ErrorGRPCStatus() string {
+	return "grpc-status %s (%s): %s"
+}
+
+func (k *KoreanLang) ErrorGRPCHTTPStatus() string {
+	return "예상하지 못한 HTTP 상태 %s"
+}
+
+func (k *KoreanLang) MsgGRPCPingStart() string {
+	return "%s에 gRPC 헬스 체크 실행 중\n"
+}
+
+func (k *KoreanLang) MsgGRPCService() string {
+	return "서비스: %s\n"
+}
+
+func (k *KoreanLang) MsgGRPCFailed() string {
+	return "gRPC %s: seq=%d 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgGRPCStatisticsTitle() string {
+	return "\n\n--- gRPC 헬스 체크 통계 ---\n"
+}
+
+func (k *KoreanLang) MsgStatisticsGRPCStatus() string {
+	return "상태 %s: %d\n"
+}
+
+// Application protocols
+func (k *KoreanLang) OptProto() string {
+	return "연결 후 프로토콜 핸드셰이크 수행: redis, mysql, postgres, memcached, mongodb, smtp, ssh, ftp"
+}
+
+func (k *KoreanLang) ErrorProtoTCPOnly() string {
+	return "--proto는 TCP 모드에서만 사용할 수 있습니다"
+}
+
+func (k *KoreanLang) ErrorProtoUnexpected() string {
+	return "예상하지 못한 응답: %s"
+}
+
+func (k *KoreanLang) MsgProtoFailed() string {
+	return "%s: seq=%d %.2fms 만에 연결했지만 %s 핸드셰이크 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgStatisticsProto() string {
+	return "애플리케이션 핸드셰이크: %d, 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+// DNS
+func (k *KoreanLang) OptDNS() string {
+	return "DNS 모드: 대상 서버에 DNS 쿼리 전송 (기본 포트 53, dot는 853)"
+}
+
+func (k *KoreanLang) OptDNSName() string {
+	return "DNS 모드에서 조회할 이름 (기본값: .)"
+}
+
+func (k *KoreanLang) OptDNSType() string {
+	return "조회할 레코드 유형 (예: A, AAAA, MX, 기본값: NS)"
+}
+
+func (k *KoreanLang) OptDNSTransport() string {
+	return "DNS 전송 방식: udp, tcp 또는 dot (DNS over TLS) (기본값: udp)"
+}
+
+func (k *KoreanLang) ErrorNotWithDNS() string {
+	return "%s는 --dns와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorDNSName() string {
+	return "잘못된 DNS 이름: %s"
+}
+
+func (k *KoreanLang) ErrorDNSReply() string {
+	return "DNS 응답이 잘못되었습니다"
+}
+
+func (k *KoreanLang) MsgDNSPingStart() string {
+	return "%s (%s - %s) 포트 %s에 DNS 쿼리 실행 중: %s %s (%s)\n"
+}
+
+func (k *KoreanLang) MsgDNSFailed() string {
+	return "DNS %s: seq=%d 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgVerboseDNSConn() string {
+	return "  연결: 연결 = %.2fms, TLS 핸드셰이크 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgDNSStatisticsTitle() string {
+	return "\n\n--- DNS 쿼리 통계 ---\n"
+}
+
+func (k *KoreanLang) MsgStatisticsDNSRcode() string {
+	return "응답 코드 %s: %d\n"
+}
+
+func (k *KoreanLang) MsgStatisticsDNSTruncated() string {
+	return "잘린 응답: %d\n"
+}
+
+// ICMP
+func (k *KoreanLang) OptICMP() string {
+	return "비특권 ping 소켓을 사용하는 ICMP 에코 모드 (Linux)"
+}
+
+func (k *KoreanLang) OptCompareICMP() string {
+	return "각 TCP 프로브 후 ICMP 에코를 보내 RTT 비교"
+}
+
+func (k *KoreanLang) ErrorICMPNotPermitted() string {
+	return "이 사용자에게 ping 소켓이 허용되지 않습니다. 다음 명령으로 그룹을 허용하세요: sysctl -w net.ipv4.ping_group_range=\"0 2147483647\""
+}
+
+func (k *KoreanLang) ErrorICMPUnsupported() string {
+	return "--icmp는 Linux 비특권 ping 소켓이 필요하며 이 플랫폼에서는 지원되지 않습니다"
+}
+
+func (k *KoreanLang) ErrorICMPPort() string {
+	return "--icmp에는 포트를 지정할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorICMPAddress() string {
+	return "잘못된 ICMP 대상 주소: %s"
+}
+
+func (k *KoreanLang) ErrorICMPTimeout() string {
+	return "%dms 안에 에코 응답이 없습니다"
+}
+
+func (k *KoreanLang) ErrorNotWithICMP() string {
+	return "%s는 --icmp 또는 --compare-icmp와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) MsgICMPPingStart() string {
+	return "%s (%s - %s)에 ICMP 에코로 Ping 실행 중\n"
+}
+
+func (k *KoreanLang) MsgICMPCompareUnavailable() string {
+	return "ICMP 비교를 사용하지 않습니다: %v\n"
+}
+
+func (k *KoreanLang) MsgICMPFailed() string {
+	return "ICMP %s: seq=%d 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgICMPStatisticsTitle() string {
+	return "\n\n--- ICMP Ping 통계 ---\n"
+}
+
+func (k *KoreanLang) MsgICMPCompareTitle() string {
+	return "\nTCP와 ICMP 비교:\n"
+}
+
+func (k *KoreanLang) MsgICMPCompareRow() string {
+	return "  %-4s 전송 = %d, 수신 = %d (%.1f%% 손실), 최소/평균/최대 = %.2f/%.2f/%.2fms\n"
+}
+
+func (k *KoreanLang) MsgICMPCompareDiff() string {
+	return "  평균 TCP - ICMP = %+.2fms\n"
+}
+
+func (k *KoreanLang) ErrorICMPUnixTarget() string {
+	return "--icmp와 --compare-icmp는 unix: 대상에 사용할 수 없습니다"
+}
+
+// NTP
+func (k *KoreanLang) OptNTP() string {
+	return "NTP 모드: SNTP 요청을 보내 시계 오프셋 보고 (기본 포트 123)"
+}
+
+func (k *KoreanLang) ErrorNotWithNTP() string {
+	return "%s는 --ntp와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorNTPKissCode() string {
+	return "서버가 요청을 거부했습니다 (kiss 코드 %s)"
+}
+
+func (k *KoreanLang) ErrorNTPUnsynchronized() string {
+	return "서버 시계가 동기화되지 않았습니다"
+}
+
+func (k *KoreanLang) ErrorNTPReply() string {
+	return "잘못된 NTP 응답"
+}
+
+func (k *KoreanLang) MsgNTPPingStart() string {
+	return "NTP 서버 %s (%s - %s) 포트 %s에 질의 중\n"
+}
+
+func (k *KoreanLang) MsgNTPFailed() string {
+	return "NTP %s: seq=%d 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgVerboseNTP() string {
+	return "  서버: 윤초 표시 = %d, 루트 지연 = %.2fms, 루트 분산 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgNTPStatisticsTitle() string {
+	return "\n\n--- NTP 질의 통계 ---\n"
+}
+
+func (k *KoreanLang) MsgStatisticsNTPOffset() string {
+	return "시계 오프셋: 최소 = %+.3fms, 최대 = %+.3fms, 평균 = %+.3fms\n"
+}
+
+// Persistent connection
+func (k *KoreanLang) OptPersistent() string {
+	return "하나의 TCP 연결을 유지하며 요청/응답 왕복 시간 측정"
+}
+
+func (k *KoreanLang) OptSend() string {
+	return "각 프로브에서 보낼 데이터 (\\r\\n 등 이스케이프 가능, 기본값: 에코 줄)"
+}
+
+func (k *KoreanLang) OptExpect() string {
+	return "응답에 포함되어야 할 데이터 (기본값: 모든 응답)"
+}
+
+func (k *KoreanLang) ErrorRequiresPersistent() string {
+	return "%s는 --persistent 또는 --find-idle-timeout이 필요합니다"
+}
+
+func (k *KoreanLang) ErrorExpectRequiresSend() string {
+	return "--expect는 --send가 필요합니다"
+}
+
+func (k *KoreanLang) ErrorNotWithPersistent() string {
+	return "%s는 --persistent와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorPersistentTimeout() string {
+	return "%dms 안에 응답이 없습니다"
+}
+
+func (k *KoreanLang) MsgPersistentPingStart() string {
+	return "%s에 대한 지속 연결 유지 중\n"
+}
+
+func (k *KoreanLang) MsgPersistentConnectFailed() string {
+	return "TCP %s: seq=%d 연결 실패: %v\n"
+}
+
+func (k *KoreanLang) MsgPersistentDropped() string {
+	return "TCP %s: seq=%d 연결 끊김: %v (연결 시간 %v, 유휴 %v)\n"
+}
+
+func (k *KoreanLang) MsgPersistentStatisticsTitle() string {
+	return "\n\n--- 지속 연결 통계 ---\n"
+}
+
+func (k *KoreanLang) MsgStatisticsPersistentConnect() string {
+	return "연결: %d, 최소 = %.2fms, 최대 = %.2fms, 평균 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgStatisticsPersistentDrops() string {
+	return "끊김: %d, 재연결: %d\n"
+}
+
+func (k *KoreanLang) MsgStatisticsPersistentLifetime() string {
+	return "끊긴 연결의 유지 시간: 최소 = %.1fs, 최대 = %.1fs, 평균 = %.1fs\n"
+}
+
+// Idle timeout discovery
+func (k *KoreanLang) OptFindIdleTimeout() string {
+	return "경로에서 유휴 연결이 유지되는 시간 탐색 (NAT/방화벽 타임아웃)"
+}
+
+func (k *KoreanLang) OptIdleMin() string {
+	return "테스트할 최단 유휴 시간 및 탐색 정밀도 (기본값: 1s)"
+}
+
+func (k *KoreanLang) OptIdleMax() string {
+	return "테스트할 최장 유휴 시간 (기본값: 10m)"
+}
+
+func (k *KoreanLang) ErrorNotWithFindIdle() string {
+	return "%s는 --find-idle-timeout과 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorIdleRange() string {
+	return "--idle-min은 0보다 크고 --idle-max 이하여야 합니다"
+}
+
+func (k *KoreanLang) MsgIdleSearchStart() string {
+	return "%s의 유휴 타임아웃을 %v ~ %v 범위에서 탐색 중\n"
+}
+
+func (k *KoreanLang) MsgIdleWaiting() string {
+	return "seq=%d: 새 연결을 %v 동안 유휴 상태로 유지 중\n"
+}
+
+func (k *KoreanLang) MsgIdleAlive() string {
+	return "TCP %s: seq=%d 유휴=%v 유지됨 time=%.2fms\n"
+}
+
+func (k *KoreanLang) MsgIdleDropped() string {
+	return "TCP %s: seq=%d 유휴=%v 끊김: %v\n"
+}
+
+func (k *KoreanLang) MsgIdleSetupFailed() string {
+	return "TCP %s: seq=%d 대상을 사용할 수 없음: %v\n"
+}
+
+func (k *KoreanLang) MsgIdleStatisticsTitle() string {
+	return "\n\n--- 유휴 타임아웃 ---\n"
+}
+
+func (k *KoreanLang) MsgIdleRange() string {
+	return "유휴 연결은 %v 초과 %v 이내에 끊어집니다\n"
+}
+
+func (k *KoreanLang) MsgIdleBelow() string {
+	return "유휴 연결은 %v 이내에 끊어집니다\n"
+}
+
+func (k *KoreanLang) MsgIdleNone() string {
+	return "%v까지 유휴 타임아웃이 없습니다\n"
+}
+
+func (k *KoreanLang) MsgIdleAtLeast() string {
+	return "유휴 연결은 최소 %v 유지됩니다 (탐색 미완료)\n"
+}
+
+func (k *KoreanLang) MsgIdleAborted() string {
+	return "탐색 중단: %v\n"
+}
+
+func (k *KoreanLang) MsgIdleChecks() string {
+	return "검사: %d, 대상 사용 불가: %d\n"
+}
+
+// Load mode
+func (k *KoreanLang) OptRate() string {
+	return "이전 프로브를 기다리지 않고 고정 속도로 프로브 시작 (예: 100/s, 600/m)"
+}
+
+func (k *KoreanLang) OptConcurrency() string {
+	return "동시에 진행되는 프로브 상한. --rate 없이 사용하면 이 수만큼 연속으로 프로브"
+}
+
+func (k *KoreanLang) ErrorNotWithLoad() string {
+	return "%s는 --rate 또는 --concurrency와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) MsgLoadStart() string {
+	return "부하 모드: 초당 %.1f 프로브, 동시 진행 최대 %d\n"
+}
+
+func (k *KoreanLang) MsgLoadStartUnlimited() string {
+	return "부하 모드: 속도 제한 없음, 동시 진행 %d\n"
+}
+
+func (k *KoreanLang) MsgLoadTitle() string {
+	return "\n--- 부하 ---\n"
+}
+
+func (k *KoreanLang) MsgLoadTarget() string {
+	return "목표: 초당 %.1f 프로브, 동시 진행 %d\n"
+}
+
+func (k *KoreanLang) MsgLoadUnlimited() string {
+	return "목표: 속도 제한 없음, 동시 진행 %d\n"
+}
+
+func (k *KoreanLang) MsgLoadAchieved() string {
+	return "시작: %d회 / %.1f초, 실제 초당 %.1f 프로브, 동시 진행 상한으로 건너뜀: %d\n"
+}
+
+func (k *KoreanLang) MsgLoadLatency() string {
+	return "지연: p50 %.2fms, p90 %.2fms, p99 %.2fms, p99.9 %.2fms, 최대 %.2fms\n"
+}
+
+func (k *KoreanLang) MsgLoadErrors() string {
+	return "오류: %s\n"
+}
+
+// Fixed-cadence scheduling
+func (k *KoreanLang) OptIntervalJitter() string {
+	return "각 프로브를 이 값까지의 임의 시간만큼 지연 (예: 200ms, 간격 이하)"
+}
+
+func (k *KoreanLang) OptMaxInFlight() string {
+	return "프로브가 간격보다 오래 걸릴 때 동시 진행 상한 (기본값: 타임아웃 / 간격)"
+}
+
+func (k *KoreanLang) ErrorJitterRange() string {
+	return "--interval-jitter는 간격보다 클 수 없습니다"
+}
+
+func (k *KoreanLang) ErrorNotWithMaxInFlight() string {
+	return "%s는 한 번에 하나씩 프로브하므로 --max-inflight와 함께 사용할 수 없습니다"
+}
+
+func (k *KoreanLang) MsgScheduleSkipped() string {
+	return "프로브 시점 %d개를 건너뜀: 진행 중인 프로브 %d개\n"
+}
+
+func (k *KoreanLang) MsgScheduleLate() string {
+	return "seq=%d 예정보다 %v 늦게 전송 (동시 진행 상한: %d)\n"
+}
+
+func (k *KoreanLang) MsgStatisticsSchedule() string {
+	return "스케줄: 늦게 전송 %d회, 건너뜀 %d회\n"
+}
+
+// Adaptive timeout and interval
+func (k *KoreanLang) OptAdaptive() string {
+	return "관측된 RTT로 타임아웃을 정하고 실패 후에는 프로브 간격을 단축"
+}
+
+func (k *KoreanLang) MsgVerboseAdaptive() string {
+	return "  적응: seq=%d 타임아웃=%v 간격=%v srtt=%v rttvar=%v\n"
+}
+
+func (k *KoreanLang) MsgStatisticsAdaptiveTimeout() string {
+	return "적응 타임아웃: 최소 = %.0fms, 최대 = %.0fms, 평균 = %.0fms\n"
+}
+
+func (k *KoreanLang) MsgStatisticsAdaptiveInterval() string {
+	return "적응 간격: 최소 = %.0fms, 최대 = %.0fms, 평균 = %.0fms\n"
+}
+
+// Recording and analyze
+func (k *KoreanLang) UsageAnalyze() string {
+	return "tcping analyze [옵션] <파일>...                 # 기록된 결과 분석"
+}
+
+func (k *KoreanLang) OptRecord() string {
+	return "모든 프로브 결과를 JSON Lines 파일에 추가 (tcping analyze 용)"
+}
+
+func (k *KoreanLang) OptAnalyzeFrom() string {
+	return "이 시각 이후의 기록만 분석"
+}
+
+func (k *KoreanLang) OptAnalyzeTo() string {
+	return "이 시각 이전의 기록만 분석"
+}
+
+func (k *KoreanLang) OptAnalyzeMerge() string {
+	return "모든 파일에서 같은 대상의 기록을 병합"
+}
+
+func (k *KoreanLang) OptAnalyzeJSON() string {
+	return "분석 결과를 JSON으로 출력"
+}
+
+func (k *KoreanLang) ErrorRecordOpen() string {
+	return "기록 파일 %s 을(를) 열 수 없습니다: %v"
+}
+
+func (k *KoreanLang) ErrorRecordWrite() string {
+	return "기록 파일 %s 쓰기 실패: %v"
+}
+
+func (k *KoreanLang) ErrorRecordParse() string {
+	return "%s:%d: 잘못된 기록: %v"
+}
+
+func (k *KoreanLang) ErrorRecordTime() string {
+	return "잘못된 시각 %q, RFC 3339 또는 2006-01-02 15:04:05 형식을 사용하세요"
+}
+
+func (k *KoreanLang) ErrorAnalyzeNoFiles() string {
+	return "tcping analyze 에는 기록 파일이 하나 이상 필요합니다"
+}
+
+func (k *KoreanLang) ErrorAnalyzeNoRecords() string {
+	return "선택한 시간 범위에 기록이 없습니다"
+}
+
+func (k *KoreanLang) MsgAnalyzeTitle() string {
+	return "\n--- %s ---\n"
+}
+
+func (k *KoreanLang) MsgAnalyzeFiles() string {
+	return "파일: %s\n"
+}
+
+func (k *KoreanLang) MsgAnalyzeRange() string {
+	return "기록: %d 건, %s - %s (%v)\n"
+}
+
+func (k *KoreanLang) MsgAnalyzePercentiles() string {
+	return "백분위수: P50 = %.2fms, P90 = %.2fms, P99 = %.2fms, P99.9 = %.2fms\n"
+}
+
+func (k *KoreanLang) MsgAnalyzeOutages() string {
+	return "중단: %d 회\n"
+}
+
+func (k *KoreanLang) MsgAnalyzeOutageOpen() string {
+	return "기록 종료"
+}
+
+func (k *KoreanLang) MsgAnalyzeCompareTitle() string {
+	return "\n비교:\n"
+}
+
+func (k *KoreanLang) MsgAnalyzeCompareRow() string {
+	return "  %s: 전송 = %d, 손실 %.1f%%, P50/P99 = %.2f/%.2fms, 중단 = %d\n"
+}
+
+// Dashboard conflicts
+func (k *KoreanLang) ErrorNotWithTUI() string {
+	return "%s는 --tui와 함께 사용할 수 없습니다"
+}
+
+// Idle timeout search: inconclusive checks
+func (k *KoreanLang) MsgIdleTimedOut() string {
+	return "TCP %s: seq=%d 유휴=%v 판정 불가: %v\n"
+}
+
+func (k *KoreanLang) MsgIdleInconclusive() string {
+	return "유휴 %v 후 응답이 타임아웃되었고 리셋이나 종료도 없어 탐색을 중단했습니다. -w 를 늘리면 느린 응답과 무통보 끊김을 구분할 수 있습니다\n"
 }
\ No newline at end of file
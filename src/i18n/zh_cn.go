@@ -299,11 +299,11 @@ func (s *SimplifiedChineseLang) MsgStatisticsRTT() string {
 }
 
 func (s *SimplifiedChineseLang) MsgStatisticsTotalData() string {
-	return "总传输数据: %d bytes (%.2f MB)\n"
+	return "线路接收数据: %d bytes (%.2f MB)\n"
 }
 
 func (s *SimplifiedChineseLang) MsgStatisticsBandwidth() string {
-	return "估算带宽: 最小 = %.2f Mbps, 最大 = %.2f Mbps, 平均 = %.2f Mbps\n"
+	return "有效吞吐量 (响应体 / 总耗时): 最小 = %.2f Mbps, 最大 = %.2f Mbps, 平均 = %.2f Mbps\n"
 }
 
 // IP type strings
@@ -313,4 +313,1103 @@ func (s *SimplifiedChineseLang) IPv4String() string {
 
 func (s *SimplifiedChineseLang) IPv6String() string {
 	return "IPv6"
+}
+
+// Argument parsing
+func (s *SimplifiedChineseLang) ErrorUnknownOption() string {
+	return "未知选项: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorOptionRequiresValue() string {
+	return "选项 %s 需要提供参数值"
+}
+
+func (s *SimplifiedChineseLang) ErrorInvalidOptionValue() string {
+	return "选项 %s 的参数值无效: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorOptionTakesNoValue() string {
+	return "选项 %s 不接受参数值"
+}
+
+func (s *SimplifiedChineseLang) ErrorTryHelp() string {
+	return "尝试 'tcping -h' 获取更多信息"
+}
+
+// Live dashboard
+func (s *SimplifiedChineseLang) OptTUI() string {
+	return "含 RTT 走势图的全屏实时仪表盘"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIUnsupported() string {
+	return "终端不支持实时仪表盘，改用逐行输出\n"
+}
+
+func (s *SimplifiedChineseLang) MsgTUITitle() string {
+	return "%s 实时仪表盘 - %s"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIElapsed() string {
+	return "已运行 %s, 已发送探测 %d"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIRTT() string {
+	return "RTT"
+}
+
+func (s *SimplifiedChineseLang) MsgTUILastRTT() string {
+	return "最近 %.2fms"
+}
+
+func (s *SimplifiedChineseLang) MsgTUILoss() string {
+	return "丢失"
+}
+
+func (s *SimplifiedChineseLang) MsgTUILossDetail() string {
+	return "%.1f%% (%d/%d 丢失)"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIStatsLabel() string {
+	return "统计"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIStats() string {
+	return "最小 %.2fms  平均 %.2fms  最大 %.2fms  p50 %.2fms  p90 %.2fms  p99 %.2fms"
+}
+
+func (s *SimplifiedChineseLang) MsgTUILastError() string {
+	return "最近错误"
+}
+
+func (s *SimplifiedChineseLang) MsgTUINone() string {
+	return "无"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIOutages() string {
+	return "中断记录"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIOutageEntry() string {
+	return "%s - %s  失败探测 %d 次  %s"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIOutageOngoing() string {
+	return "进行中"
+}
+
+func (s *SimplifiedChineseLang) MsgTUIHint() string {
+	return "按 Ctrl-C 停止"
+}
+
+// Interim statistics
+func (s *SimplifiedChineseLang) OptSummaryEvery() string {
+	return "定期输出阶段统计 (例如 60s)，收到 SIGQUIT/SIGUSR1 时立即输出"
+}
+
+func (s *SimplifiedChineseLang) OptSummaryWindow() string {
+	return "阶段统计中同时输出最近一个区间的数据"
+}
+
+func (s *SimplifiedChineseLang) MsgInterimTitle() string {
+	return "\n--- 阶段统计 %s (已运行 %s) ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgInterimWindow() string {
+	return "最近 %s:\n"
+}
+
+// Stop conditions
+func (s *SimplifiedChineseLang) OptDuration() string {
+	return "运行指定时间后停止 (例如 10m)"
+}
+
+func (s *SimplifiedChineseLang) OptUntilSuccess() string {
+	return "第一次成功后停止 (等待端口开放)"
+}
+
+func (s *SimplifiedChineseLang) OptUntilFailure() string {
+	return "第一次失败后停止"
+}
+
+func (s *SimplifiedChineseLang) OptMaxFailures() string {
+	return "累计失败达到此次数后停止"
+}
+
+func (s *SimplifiedChineseLang) MsgStopDuration() string {
+	return "\n已停止: 达到运行时间 %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStopUntilSuccess() string {
+	return "\n已停止: 目标已响应 (--until-success)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStopUntilFailure() string {
+	return "\n已停止: 探测失败 (--until-failure)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStopMaxFailures() string {
+	return "\n已停止: 失败次数达到 %d 次 (--max-failures)\n"
+}
+
+// Thresholds and verdict
+func (s *SimplifiedChineseLang) OptMaxLoss() string {
+	return "丢失率超过此百分比时判定失败 (例如 5%)"
+}
+
+func (s *SimplifiedChineseLang) OptMaxAvgRTT() string {
+	return "平均 RTT 超过此时间时判定失败 (例如 50ms)"
+}
+
+func (s *SimplifiedChineseLang) OptMaxP99RTT() string {
+	return "p99 RTT 超过此时间时判定失败"
+}
+
+func (s *SimplifiedChineseLang) OptJSON() string {
+	return "以 JSON 格式输出最终统计与判定"
+}
+
+func (s *SimplifiedChineseLang) MsgThresholdLoss() string {
+	return "丢失率 %.1f%% 超过上限 %.1f%%"
+}
+
+func (s *SimplifiedChineseLang) MsgThresholdAvgRTT() string {
+	return "平均 RTT %.2fms 超过上限 %.2fms"
+}
+
+func (s *SimplifiedChineseLang) MsgThresholdP99RTT() string {
+	return "p99 RTT %.2fms 超过上限 %.2fms"
+}
+
+func (s *SimplifiedChineseLang) MsgThresholdNoResponse() string {
+	return "没有成功的响应"
+}
+
+func (s *SimplifiedChineseLang) MsgVerdictPass() string {
+	return "判定: 通过\n"
+}
+
+func (s *SimplifiedChineseLang) MsgVerdictFail() string {
+	return "判定: 未通过 (%s)\n"
+}
+
+// HTTP transfer
+func (s *SimplifiedChineseLang) MsgStatisticsBodyData() string {
+	return "响应体 (解压后): %d bytes (%.2f MB)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseHTTPTransfer() string {
+	return "    耗时: 响应头 = %.2fms, 响应体 = %.2fms, 总计 = %.2fms\n"
+}
+
+// Throughput test
+func (s *SimplifiedChineseLang) OptThroughput() string {
+	return "以持续传输测量 HTTP 吞吐量，代替健康检查请求"
+}
+
+func (s *SimplifiedChineseLang) OptUploadSize() string {
+	return "上传指定大小 (如 10M) 的生成数据，代替下载"
+}
+
+func (s *SimplifiedChineseLang) OptStreams() string {
+	return "并行传输流数量 (默认 1)"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputDownload() string {
+	return "下载"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputUpload() string {
+	return "上传"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputStart() string {
+	return "吞吐量测试 (%s, %d 个传输流)，每 %s 采样一次\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputSample() string {
+	return "  %7.2fs  %10.2f Mbps  (%d bytes)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputStreamFailed() string {
+	return "  传输流 %d 失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputStreamDone() string {
+	return "  传输流 %d: %d bytes，耗时 %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputStatisticsTitle() string {
+	return "\n--- HTTP 吞吐量统计 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputStreams() string {
+	return "传输流 = %d, 完成 = %d, 失败 = %d\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputTransferred() string {
+	return "传输量 (%s): %d bytes (%.2f MB)，耗时 %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputRampUp() string {
+	return "爬升时间: %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputSustained() string {
+	return "持续吞吐量: 最小 = %.2f Mbps, 最大 = %.2f Mbps, 平均 = %.2f Mbps\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputFinal() string {
+	return "最终吞吐量: %.2f Mbps\n"
+}
+
+func (s *SimplifiedChineseLang) MsgThroughputTTFB() string {
+	return "首字节时间: 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// HTTP connection reuse
+func (s *SimplifiedChineseLang) OptHTTPReuse() string {
+	return "在 HTTP 探测之间复用 keep-alive 连接 (默认)"
+}
+
+func (s *SimplifiedChineseLang) OptHTTPFresh() string {
+	return "每次 HTTP 探测都建立新的 TCP/TLS 连接"
+}
+
+func (s *SimplifiedChineseLang) ErrorRequiresHTTPMode() string {
+	return "%s 需要 HTTP 模式 (-H)"
+}
+
+func (s *SimplifiedChineseLang) ErrorHTTPReuseFresh() string {
+	return "--http-reuse 和 --http-fresh 不能同时使用"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseHTTPConnReused() string {
+	return "    连接: 复用, 本地端口 %d, 空闲 %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseHTTPConnNew() string {
+	return "    连接: 新建, 本地端口 %d, 连接 = %.2fms, TLS 握手 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsReusedConns() string {
+	return "复用连接: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsNewConns() string {
+	return "新建连接: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// HTTP protocol version
+func (s *SimplifiedChineseLang) OptHTTP2() string {
+	return "强制使用 HTTP/2 (https 通过 ALPN 协商 h2，http 使用 h2c prior knowledge)"
+}
+
+func (s *SimplifiedChineseLang) OptHTTP3() string {
+	return "使用基于 QUIC 的 HTTP/3 (仅限 https)"
+}
+
+func (s *SimplifiedChineseLang) ErrorHTTP2HTTP3() string {
+	return "--http2 和 --http3 不能同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorHTTP3RequiresHTTPS() string {
+	return "--http3 需要 https:// URI"
+}
+
+// HTTP redirects
+func (s *SimplifiedChineseLang) OptFollow() string {
+	return "跟随 HTTP 重定向并报告最终响应"
+}
+
+func (s *SimplifiedChineseLang) OptMaxRedirs() string {
+	return "最多跟随的重定向次数 (默认 10)"
+}
+
+func (s *SimplifiedChineseLang) ErrorRedirectDowngrade() string {
+	return "重定向从 https 降级为 http: %v -> %v"
+}
+
+func (s *SimplifiedChineseLang) ErrorRedirectLoop() string {
+	return "检测到重定向循环: %v"
+}
+
+func (s *SimplifiedChineseLang) ErrorTooManyRedirects() string {
+	return "已重定向 %d 次，停止跟随"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseHTTPRedirect() string {
+	return "    重定向 %d: %d %s -> %s (%.2fms)\n"
+}
+
+// TLS options
+func (s *SimplifiedChineseLang) OptCACert() string {
+	return "信任此 PEM 文件中的 CA 证书，代替系统根证书"
+}
+
+func (s *SimplifiedChineseLang) OptCert() string {
+	return "双向 TLS 使用的客户端证书 (PEM)"
+}
+
+func (s *SimplifiedChineseLang) OptKey() string {
+	return "--cert 的私钥 (PEM) (默认从 --cert 文件读取)"
+}
+
+func (s *SimplifiedChineseLang) OptTLSMin() string {
+	return "最低 TLS 版本 (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (s *SimplifiedChineseLang) OptTLSMax() string {
+	return "最高 TLS 版本 (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (s *SimplifiedChineseLang) OptCiphers() string {
+	return "TLS 1.2 及以下允许的密码套件 (以逗号分隔)"
+}
+
+func (s *SimplifiedChineseLang) OptSNI() string {
+	return "TLS 握手时发送并用于验证证书的服务器名称"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSVersionRange() string {
+	return "--tls-min 不能高于 --tls-max"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSLoadCACert() string {
+	return "无法读取 CA 证书文件 %s: %w"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSNoCACerts() string {
+	return "%s 中没有找到 PEM 证书"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSKeyWithoutCert() string {
+	return "--key 需要同时指定 --cert"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSLoadClientCert() string {
+	return "无法加载客户端证书: %w"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSUnknownAuthority() string {
+	return "证书由未知的证书颁发机构签发 (颁发者: %s)，可使用 --cacert 信任"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSHostname() string {
+	return "证书对 %s 无效 (有效名称: %s)"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSExpired() string {
+	return "证书已过期或尚未生效 (有效期 %s 至 %s)"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSInvalidCert() string {
+	return "证书无效: %v"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSNotTLS() string {
+	return "服务器没有以 TLS 响应 (是否为 http:// 端口?)"
+}
+
+func (s *SimplifiedChineseLang) ErrorTLSRemoteAlert() string {
+	return "服务器拒绝了 TLS 握手: %v"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseHTTPTLS() string {
+	return "    TLS: %s, %s, 服务器名称 %s\n"
+}
+
+// Proxy
+func (s *SimplifiedChineseLang) OptProxy() string {
+	return "通过 HTTP CONNECT 或 SOCKS5 代理连接 (支持 http://、socks5://、socks5h://、user:pass@)"
+}
+
+func (s *SimplifiedChineseLang) OptProxyEnv() string {
+	return "使用 HTTP_PROXY/HTTPS_PROXY 指定的代理，并遵循 NO_PROXY"
+}
+
+func (s *SimplifiedChineseLang) ErrorProxyURL() string {
+	return "无效的代理地址: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorProxyScheme() string {
+	return "不支持的代理协议 %q (请使用 http、socks5 或 socks5h)"
+}
+
+func (s *SimplifiedChineseLang) ErrorProxyHTTP3() string {
+	return "--http3 不能通过代理使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorProxyDial() string {
+	return "无法连接到代理 %v: %v"
+}
+
+func (s *SimplifiedChineseLang) ErrorProxyConnect() string {
+	return "代理拒绝了 CONNECT: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorSOCKSProtocol() string {
+	return "代理返回了无效的 SOCKS5 响应"
+}
+
+func (s *SimplifiedChineseLang) ErrorSOCKSNoMethod() string {
+	return "SOCKS5 代理要求不支持的认证方式"
+}
+
+func (s *SimplifiedChineseLang) ErrorSOCKSAuth() string {
+	return "SOCKS5 代理认证失败"
+}
+
+func (s *SimplifiedChineseLang) ErrorSOCKSReply() string {
+	return "SOCKS5 代理无法连接到目标: %s"
+}
+
+func (s *SimplifiedChineseLang) MsgViaProxy() string {
+	return "使用代理 %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgTCPPingStartProxy() string {
+	return "正在对 %s 端口 %s 执行 TCP Ping (地址由代理解析)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseHTTPProxy() string {
+	return "    代理: %s, 连接代理 = %.2fms, 建立隧道 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsProxyConnect() string {
+	return "连接代理: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsProxyTunnel() string {
+	return "到目标的隧道: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// Unix domain sockets
+func (s *SimplifiedChineseLang) OptUnixSocket() string {
+	return "通过此 Unix 域套接字发送 HTTP 探测 (URI 只提供路径和 Host)"
+}
+
+func (s *SimplifiedChineseLang) ErrorUnixSocketConflict() string {
+	return "Unix 域套接字不能与 --proxy 或 --http3 同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorUnixTargetPort() string {
+	return "unix: 目标不能指定端口"
+}
+
+func (s *SimplifiedChineseLang) MsgUnixPingStart() string {
+	return "正在连接 Unix 域套接字 %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgViaUnixSocket() string {
+	return "使用 Unix 域套接字 %s\n"
+}
+
+// WebSocket
+func (s *SimplifiedChineseLang) OptWebSocket() string {
+	return "WebSocket 模式: 与 ws:// 或 wss:// URI 握手后测量 ping/pong 往返时间"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithWebSocket() string {
+	return "%s 不能与 --ws 同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorWSRequiresURI() string {
+	return "WebSocket 模式需要提供 URI\n\n用法: tcping --ws [选项] <ws://...|wss://...>\n尝试 'tcping -h' 获取更多信息"
+}
+
+func (s *SimplifiedChineseLang) ErrorWSURI() string {
+	return "WebSocket URI 必须以 ws:// 或 wss:// 开头: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorWSHandshakeStatus() string {
+	return "WebSocket 握手被拒绝: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorWSHandshakeInvalid() string {
+	return "无效的 WebSocket 握手响应"
+}
+
+func (s *SimplifiedChineseLang) ErrorWSProtocol() string {
+	return "WebSocket 协议错误: 控制帧过大"
+}
+
+func (s *SimplifiedChineseLang) ErrorWSClosed() string {
+	return "服务器关闭了连接 (代码 %d %s)"
+}
+
+func (s *SimplifiedChineseLang) ErrorWSPongTimeout() string {
+	return "%dms 内没有收到 pong"
+}
+
+func (s *SimplifiedChineseLang) MsgWSPingStart() string {
+	return "正在对 %s 执行 WebSocket ping\n"
+}
+
+func (s *SimplifiedChineseLang) MsgWSFailed() string {
+	return "WebSocket %s: seq=%d 失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseWSHandshake() string {
+	return "  握手: 总计 = %.2fms, 连接 = %.2fms, TLS 握手 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgWSStatisticsTitle() string {
+	return "\n\n--- WebSocket ping 统计 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsWSHandshake() string {
+	return "握手: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsWSReconnects() string {
+	return "重连: %d\n"
+}
+
+// gRPC
+func (s *SimplifiedChineseLang) OptGRPC() string {
+	return "gRPC 模式: 调用 host:port 的 grpc.health.v1.Health/Check，SERVING 视为成功"
+}
+
+func (s *SimplifiedChineseLang) OptGRPCService() string {
+	return "gRPC 模式下检查的服务名 (默认: 整个服务器)"
+}
+
+func (s *SimplifiedChineseLang) ErrorRequiresGRPC() string {
+	return "%s 需要与 --grpc 一起使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithGRPC() string {
+	return "%s 不能与 --grpc 同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorGRPCRequiresTarget() string {
+	return "gRPC 模式需要提供目标\n\n用法: tcping --grpc [选项] <host:port>\n尝试 'tcping -h' 获取更多信息"
+}
+
+func (s *SimplifiedChineseLang) ErrorGRPCTarget() string {
+	return "无效的 gRPC 目标 (应为 host:port): %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorGRPCResponse() string {
+	return "无效的健康检查响应"
+}
+
+func (s *SimplifiedChineseLang) ErrorGRPCStatus() string {
+	return "grpc-status %s (%s): %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorGRPCHTTPStatus() string {
+	return "意外的 HTTP 状态 %s"
+}
+
+func (s *SimplifiedChineseLang) MsgGRPCPingStart() string {
+	return "正在对 %s 执行 gRPC 健康检查\n"
+}
+
+func (s *SimplifiedChineseLang) MsgGRPCService() string {
+	return "服务: %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgGRPCFailed() string {
+	return "gRPC %s: seq=%d 失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgGRPCStatisticsTitle() string {
+	return "\n\n--- gRPC 健康检查统计 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsGRPCStatus() string {
+	return "状态 %s: %d\n"
+}
+
+// Application protocols
+func (s *SimplifiedChineseLang) OptProto() string {
+	return "连接后进行应用层协议握手: redis, mysql, postgres, memcached, mongodb, smtp, ssh, ftp"
+}
+
+func (s *SimplifiedChineseLang) ErrorProtoTCPOnly() string {
+	return "--proto 只能在 TCP 模式下使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorProtoUnexpected() string {
+	return "意外的响应: %s"
+}
+
+func (s *SimplifiedChineseLang) MsgProtoFailed() string {
+	return "%s: seq=%d 已在 %.2fms 内连接，但 %s 握手失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsProto() string {
+	return "应用层握手: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// DNS
+func (s *SimplifiedChineseLang) OptDNS() string {
+	return "DNS 模式: 向目标服务器发送 DNS 查询 (默认端口 53，dot 为 853)"
+}
+
+func (s *SimplifiedChineseLang) OptDNSName() string {
+	return "DNS 模式下查询的名称 (默认: .)"
+}
+
+func (s *SimplifiedChineseLang) OptDNSType() string {
+	return "查询的记录类型，如 A、AAAA、MX (默认: NS)"
+}
+
+func (s *SimplifiedChineseLang) OptDNSTransport() string {
+	return "DNS 传输方式: udp、tcp 或 dot (DNS over TLS) (默认: udp)"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithDNS() string {
+	return "%s 不能与 --dns 同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorDNSName() string {
+	return "无效的 DNS 名称: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorDNSReply() string {
+	return "无效的 DNS 响应"
+}
+
+func (s *SimplifiedChineseLang) MsgDNSPingStart() string {
+	return "正在对 %s (%s - %s) 端口 %s 执行 DNS 查询: %s %s (%s)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgDNSFailed() string {
+	return "DNS %s: seq=%d 失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseDNSConn() string {
+	return "  连接: 连接 = %.2fms, TLS 握手 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgDNSStatisticsTitle() string {
+	return "\n\n--- DNS 查询统计 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsDNSRcode() string {
+	return "响应码 %s: %d\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsDNSTruncated() string {
+	return "被截断的响应: %d\n"
+}
+
+// ICMP
+func (s *SimplifiedChineseLang) OptICMP() string {
+	return "使用非特权 ping 套接字的 ICMP 回显模式 (Linux)"
+}
+
+func (s *SimplifiedChineseLang) OptCompareICMP() string {
+	return "每次 TCP 探测后发送 ICMP 回显并比较往返时间"
+}
+
+func (s *SimplifiedChineseLang) ErrorICMPNotPermitted() string {
+	return "当前用户不允许使用 ping 套接字，可以这样允许用户组: sysctl -w net.ipv4.ping_group_range=\"0 2147483647\""
+}
+
+func (s *SimplifiedChineseLang) ErrorICMPUnsupported() string {
+	return "--icmp 需要 Linux 的非特权 ping 套接字，此平台不支持"
+}
+
+func (s *SimplifiedChineseLang) ErrorICMPPort() string {
+	return "--icmp 不能指定端口"
+}
+
+func (s *SimplifiedChineseLang) ErrorICMPAddress() string {
+	return "无效的 ICMP 目标地址: %s"
+}
+
+func (s *SimplifiedChineseLang) ErrorICMPTimeout() string {
+	return "%dms 内没有收到回显应答"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithICMP() string {
+	return "%s 不能与 --icmp 或 --compare-icmp 同时使用"
+}
+
+func (s *SimplifiedChineseLang) MsgICMPPingStart() string {
+	return "正在对 %s (%s - %s) 执行 ICMP Ping\n"
+}
+
+func (s *SimplifiedChineseLang) MsgICMPCompareUnavailable() string {
+	return "已禁用 ICMP 对比: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgICMPFailed() string {
+	return "ICMP %s: seq=%d 失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgICMPStatisticsTitle() string {
+	return "\n\n--- ICMP Ping 统计 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgICMPCompareTitle() string {
+	return "\nTCP 与 ICMP 对比:\n"
+}
+
+func (s *SimplifiedChineseLang) MsgICMPCompareRow() string {
+	return "  %-4s 已发送 = %d, 已接收 = %d (%.1f%% 丢失), 最小/平均/最大 = %.2f/%.2f/%.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgICMPCompareDiff() string {
+	return "  平均 TCP - ICMP = %+.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) ErrorICMPUnixTarget() string {
+	return "--icmp 和 --compare-icmp 不能用于 unix: 目标"
+}
+
+// NTP
+func (s *SimplifiedChineseLang) OptNTP() string {
+	return "NTP 模式: 发送 SNTP 请求并报告时钟偏移 (默认端口 123)"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithNTP() string {
+	return "%s 不能与 --ntp 同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorNTPKissCode() string {
+	return "服务器拒绝了请求 (kiss 代码 %s)"
+}
+
+func (s *SimplifiedChineseLang) ErrorNTPUnsynchronized() string {
+	return "服务器时钟未同步"
+}
+
+func (s *SimplifiedChineseLang) ErrorNTPReply() string {
+	return "无效的 NTP 响应"
+}
+
+func (s *SimplifiedChineseLang) MsgNTPPingStart() string {
+	return "正在查询 NTP 服务器 %s (%s - %s) 端口 %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgNTPFailed() string {
+	return "NTP %s: seq=%d 失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseNTP() string {
+	return "  服务器: 闰秒指示 = %d, 根延迟 = %.2fms, 根离散 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgNTPStatisticsTitle() string {
+	return "\n\n--- NTP 查询统计 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsNTPOffset() string {
+	return "时钟偏移: 最小 = %+.3fms, 最大 = %+.3fms, 平均 = %+.3fms\n"
+}
+
+// Persistent connection
+func (s *SimplifiedChineseLang) OptPersistent() string {
+	return "保持一个 TCP 连接并测量其上请求/应答的往返时间"
+}
+
+func (s *SimplifiedChineseLang) OptSend() string {
+	return "每次探测发送的数据 (可使用 \\r\\n 等转义序列，默认: 回显行)"
+}
+
+func (s *SimplifiedChineseLang) OptExpect() string {
+	return "应答必须包含的数据 (默认: 任意应答)"
+}
+
+func (s *SimplifiedChineseLang) ErrorRequiresPersistent() string {
+	return "%s 需要与 --persistent 或 --find-idle-timeout 一起使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorExpectRequiresSend() string {
+	return "--expect 需要与 --send 一起使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithPersistent() string {
+	return "%s 不能与 --persistent 同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorPersistentTimeout() string {
+	return "%dms 内没有收到应答"
+}
+
+func (s *SimplifiedChineseLang) MsgPersistentPingStart() string {
+	return "正在保持到 %s 的持久连接\n"
+}
+
+func (s *SimplifiedChineseLang) MsgPersistentConnectFailed() string {
+	return "TCP %s: seq=%d 连接失败: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgPersistentDropped() string {
+	return "TCP %s: seq=%d 连接断开: %v (已连接 %v，空闲 %v)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgPersistentStatisticsTitle() string {
+	return "\n\n--- 持久连接统计 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsPersistentConnect() string {
+	return "连接: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsPersistentDrops() string {
+	return "断开: %d, 重连: %d\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsPersistentLifetime() string {
+	return "断开连接的存活时间: 最小 = %.1fs, 最大 = %.1fs, 平均 = %.1fs\n"
+}
+
+// Idle timeout discovery
+func (s *SimplifiedChineseLang) OptFindIdleTimeout() string {
+	return "探测路径上空闲连接能保持多久 (NAT/防火墙超时)"
+}
+
+func (s *SimplifiedChineseLang) OptIdleMin() string {
+	return "测试的最短空闲时间及搜索精度 (默认: 1s)"
+}
+
+func (s *SimplifiedChineseLang) OptIdleMax() string {
+	return "测试的最长空闲时间 (默认: 10m)"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithFindIdle() string {
+	return "%s 不能与 --find-idle-timeout 同时使用"
+}
+
+func (s *SimplifiedChineseLang) ErrorIdleRange() string {
+	return "--idle-min 必须大于 0 且不大于 --idle-max"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleSearchStart() string {
+	return "正在搜索 %s 的空闲超时，范围 %v 到 %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleWaiting() string {
+	return "seq=%d: 保持新连接空闲 %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleAlive() string {
+	return "TCP %s: seq=%d 空闲=%v 存活 time=%.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleDropped() string {
+	return "TCP %s: seq=%d 空闲=%v 已断开: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleSetupFailed() string {
+	return "TCP %s: seq=%d 目标不可用: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleStatisticsTitle() string {
+	return "\n\n--- 空闲超时 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleRange() string {
+	return "空闲连接在超过 %v、不超过 %v 后被断开\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleBelow() string {
+	return "空闲连接在 %v 内被断开\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleNone() string {
+	return "在 %v 内没有空闲超时\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleAtLeast() string {
+	return "空闲连接至少能保持 %v (搜索未完成)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleAborted() string {
+	return "搜索已中止: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleChecks() string {
+	return "检查: %d, 目标不可用: %d\n"
+}
+
+// Load mode
+func (s *SimplifiedChineseLang) OptRate() string {
+	return "不等待之前的探测，以固定速率发起探测，如 100/s 或 600/m"
+}
+
+func (s *SimplifiedChineseLang) OptConcurrency() string {
+	return "同时进行的探测上限；未指定 --rate 时以此数量连续探测"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithLoad() string {
+	return "%s 不能与 --rate 或 --concurrency 同时使用"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadStart() string {
+	return "负载模式: 每秒 %.1f 次探测，最多同时进行 %d 次\n"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadStartUnlimited() string {
+	return "负载模式: 不限速，同时进行 %d 次探测\n"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadTitle() string {
+	return "\n--- 负载 ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadTarget() string {
+	return "目标: 每秒 %.1f 次探测，并发 %d\n"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadUnlimited() string {
+	return "目标: 不限速，并发 %d\n"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadAchieved() string {
+	return "已发起: %d 次，耗时 %.1f 秒，实际每秒 %.1f 次探测，因并发上限跳过: %d\n"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadLatency() string {
+	return "延迟: p50 %.2fms, p90 %.2fms, p99 %.2fms, p99.9 %.2fms, 最大 %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgLoadErrors() string {
+	return "错误: %s\n"
+}
+
+// Fixed-cadence scheduling
+func (s *SimplifiedChineseLang) OptIntervalJitter() string {
+	return "每次探测随机推迟不超过此值的时间，如 200ms (不大于间隔)"
+}
+
+func (s *SimplifiedChineseLang) OptMaxInFlight() string {
+	return "探测耗时超过间隔时同时进行的上限 (默认: 超时 / 间隔)"
+}
+
+func (s *SimplifiedChineseLang) ErrorJitterRange() string {
+	return "--interval-jitter 不能大于间隔"
+}
+
+func (s *SimplifiedChineseLang) ErrorNotWithMaxInFlight() string {
+	return "%s 只能逐次探测，不能与 --max-inflight 同时使用"
+}
+
+func (s *SimplifiedChineseLang) MsgScheduleSkipped() string {
+	return "已跳过 %d 个探测时间点: 仍有 %d 次探测在进行\n"
+}
+
+func (s *SimplifiedChineseLang) MsgScheduleLate() string {
+	return "seq=%d 比计划晚 %v 发出 (同时进行上限: %d)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsSchedule() string {
+	return "调度: 延后发出 %d 次，跳过 %d 个时间点\n"
+}
+
+// Adaptive timeout and interval
+func (s *SimplifiedChineseLang) OptAdaptive() string {
+	return "根据观测到的 RTT 决定超时，失败后缩短探测间隔"
+}
+
+func (s *SimplifiedChineseLang) MsgVerboseAdaptive() string {
+	return "  自适应: seq=%d 超时=%v 间隔=%v srtt=%v rttvar=%v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsAdaptiveTimeout() string {
+	return "自适应超时: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgStatisticsAdaptiveInterval() string {
+	return "自适应间隔: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+}
+
+// Recording and analyze
+func (s *SimplifiedChineseLang) UsageAnalyze() string {
+	return "tcping analyze [选项] <文件>...                 # 分析记录的结果"
+}
+
+func (s *SimplifiedChineseLang) OptRecord() string {
+	return "将每次探测结果追加写入 JSON Lines 文件，供 tcping analyze 使用"
+}
+
+func (s *SimplifiedChineseLang) OptAnalyzeFrom() string {
+	return "只分析此时间及之后的记录"
+}
+
+func (s *SimplifiedChineseLang) OptAnalyzeTo() string {
+	return "只分析此时间之前的记录"
+}
+
+func (s *SimplifiedChineseLang) OptAnalyzeMerge() string {
+	return "合并所有文件中同一目标的记录"
+}
+
+func (s *SimplifiedChineseLang) OptAnalyzeJSON() string {
+	return "以 JSON 输出分析结果"
+}
+
+func (s *SimplifiedChineseLang) ErrorRecordOpen() string {
+	return "无法打开记录文件 %s: %v"
+}
+
+func (s *SimplifiedChineseLang) ErrorRecordWrite() string {
+	return "写入记录文件 %s 失败: %v"
+}
+
+func (s *SimplifiedChineseLang) ErrorRecordParse() string {
+	return "%s:%d: 无效的记录: %v"
+}
+
+func (s *SimplifiedChineseLang) ErrorRecordTime() string {
+	return "无效的时间 %q，请使用 RFC 3339 或 2006-01-02 15:04:05 格式"
+}
+
+func (s *SimplifiedChineseLang) ErrorAnalyzeNoFiles() string {
+	return "tcping analyze 需要至少一个记录文件"
+}
+
+func (s *SimplifiedChineseLang) ErrorAnalyzeNoRecords() string {
+	return "所选时间范围内没有记录"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzeTitle() string {
+	return "\n--- %s ---\n"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzeFiles() string {
+	return "文件: %s\n"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzeRange() string {
+	return "记录: %d 条, %s - %s (%v)\n"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzePercentiles() string {
+	return "百分位数: P50 = %.2fms, P90 = %.2fms, P99 = %.2fms, P99.9 = %.2fms\n"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzeOutages() string {
+	return "中断: %d 次\n"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzeOutageOpen() string {
+	return "记录结束"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzeCompareTitle() string {
+	return "\n比较:\n"
+}
+
+func (s *SimplifiedChineseLang) MsgAnalyzeCompareRow() string {
+	return "  %s: 已发送 = %d, 丢失 %.1f%%, P50/P99 = %.2f/%.2fms, 中断 = %d\n"
+}
+
+// Dashboard conflicts
+func (s *SimplifiedChineseLang) ErrorNotWithTUI() string {
+	return "%s 不能与 --tui 同时使用"
+}
+
+// Idle timeout search: inconclusive checks
+func (s *SimplifiedChineseLang) MsgIdleTimedOut() string {
+	return "TCP %s: seq=%d 空闲=%v 无法判定: %v\n"
+}
+
+func (s *SimplifiedChineseLang) MsgIdleInconclusive() string {
+	return "空闲 %v 后请求超时，未收到重置或关闭，无法确定连接是否已断开，搜索已停止；增大 -w 可以区分应答缓慢和静默丢弃\n"
 }
\ No newline at end of file
@@ -299,11 +299,11 @@ func (t *TraditionalChineseLang) MsgStatisticsRTT() string {
 }
 
 func (t *TraditionalChineseLang) MsgStatisticsTotalData() string {
-	return "總傳輸資料: %d bytes (%.2f MB)\n"
+	return "線路接收資料: %d bytes (%.2f MB)\n"
 }
 
 func (t *TraditionalChineseLang) MsgStatisticsBandwidth() string {
-	return "估算頻寬: 最小 = %.2f Mbps, 最大 = %.2f Mbps, 平均 = %.2f Mbps\n"
+	return "有效吞吐量 (回應本文 / 總耗時): 最小 = %.2f Mbps, 最大 = %.2f Mbps, 平均 = %.2f Mbps\n"
 }
 
 // IP type strings
@@ -313,4 +313,1103 @@ func (t *TraditionalChineseLang) IPv4String() string {
 
 func (t *TraditionalChineseLang) IPv6String() string {
 	return "IPv6"
+}
+
+// Argument parsing
+func (t *TraditionalChineseLang) ErrorUnknownOption() string {
+	return "未知選項: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorOptionRequiresValue() string {
+	return "選項 %s 需要提供參數值"
+}
+
+func (t *TraditionalChineseLang) ErrorInvalidOptionValue() string {
+	return "選項 %s 的參數值無效: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorOptionTakesNoValue() string {
+	return "選項 %s 不接受參數值"
+}
+
+func (t *TraditionalChineseLang) ErrorTryHelp() string {
+	return "嘗試 'tcping -h' 取得更多資訊"
+}
+
+// Live dashboard
+func (t *TraditionalChineseLang) OptTUI() string {
+	return "含 RTT 走勢圖的全螢幕即時儀表板"
+}
+
+func (t *TraditionalChineseLang) MsgTUIUnsupported() string {
+	return "終端機不支援即時儀表板，改用逐行輸出\n"
+}
+
+func (t *TraditionalChineseLang) MsgTUITitle() string {
+	return "%s 即時儀表板 - %s"
+}
+
+func (t *TraditionalChineseLang) MsgTUIElapsed() string {
+	return "已執行 %s, 已發送探測 %d"
+}
+
+func (t *TraditionalChineseLang) MsgTUIRTT() string {
+	return "RTT"
+}
+
+func (t *TraditionalChineseLang) MsgTUILastRTT() string {
+	return "最近 %.2fms"
+}
+
+func (t *TraditionalChineseLang) MsgTUILoss() string {
+	return "遺失"
+}
+
+func (t *TraditionalChineseLang) MsgTUILossDetail() string {
+	return "%.1f%% (%d/%d 遺失)"
+}
+
+func (t *TraditionalChineseLang) MsgTUIStatsLabel() string {
+	return "統計"
+}
+
+func (t *TraditionalChineseLang) MsgTUIStats() string {
+	return "最小 %.2fms  平均 %.2fms  最大 %.2fms  p50 %.2fms  p90 %.2fms  p99 %.2fms"
+}
+
+func (t *TraditionalChineseLang) MsgTUILastError() string {
+	return "最近錯誤"
+}
+
+func (t *TraditionalChineseLang) MsgTUINone() string {
+	return "無"
+}
+
+func (t *TraditionalChineseLang) MsgTUIOutages() string {
+	return "中斷紀錄"
+}
+
+func (t *TraditionalChineseLang) MsgTUIOutageEntry() string {
+	return "%s - %s  失敗探測 %d 次  %s"
+}
+
+func (t *TraditionalChineseLang) MsgTUIOutageOngoing() string {
+	return "進行中"
+}
+
+func (t *TraditionalChineseLang) MsgTUIHint() string {
+	return "按 Ctrl-C 停止"
+}
+
+// Interim statistics
+func (t *TraditionalChineseLang) OptSummaryEvery() string {
+	return "定期輸出階段統計 (例如 60s)，收到 SIGQUIT/SIGUSR1 時立即輸出"
+}
+
+func (t *TraditionalChineseLang) OptSummaryWindow() string {
+	return "階段統計中同時輸出最近一個區間的資料"
+}
+
+func (t *TraditionalChineseLang) MsgInterimTitle() string {
+	return "\n--- 階段統計 %s (已執行 %s) ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgInterimWindow() string {
+	return "最近 %s:\n"
+}
+
+// Stop conditions
+func (t *TraditionalChineseLang) OptDuration() string {
+	return "執行指定時間後停止 (例如 10m)"
+}
+
+func (t *TraditionalChineseLang) OptUntilSuccess() string {
+	return "第一次成功後停止 (等待連接埠開放)"
+}
+
+func (t *TraditionalChineseLang) OptUntilFailure() string {
+	return "第一次失敗後停止"
+}
+
+func (t *TraditionalChineseLang) OptMaxFailures() string {
+	return "累計失敗達到此次數後停止"
+}
+
+func (t *TraditionalChineseLang) MsgStopDuration() string {
+	return "\n已停止: 達到執行時間 %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgStopUntilSuccess() string {
+	return "\n已停止: 目標已回應 (--until-success)\n"
+}
+
+func (t *TraditionalChineseLang) MsgStopUntilFailure() string {
+	return "\n已停止: 探測失敗 (--until-failure)\n"
+}
+
+func (t *TraditionalChineseLang) MsgStopMaxFailures() string {
+	return "\n已停止: 失敗次數達到 %d 次 (--max-failures)\n"
+}
+
+// Thresholds and verdict
+func (t *TraditionalChineseLang) OptMaxLoss() string {
+	return "遺失率超過此百分比時判定失敗 (例如 5%)"
+}
+
+func (t *TraditionalChineseLang) OptMaxAvgRTT() string {
+	return "平均 RTT 超過此時間時判定失敗 (例如 50ms)"
+}
+
+func (t *TraditionalChineseLang) OptMaxP99RTT() string {
+	return "p99 RTT 超過此時間時判定失敗"
+}
+
+func (t *TraditionalChineseLang) OptJSON() string {
+	return "以 JSON 格式輸出最終統計與判定"
+}
+
+func (t *TraditionalChineseLang) MsgThresholdLoss() string {
+	return "遺失率 %.1f%% 超過上限 %.1f%%"
+}
+
+func (t *TraditionalChineseLang) MsgThresholdAvgRTT() string {
+	return "平均 RTT %.2fms 超過上限 %.2fms"
+}
+
+func (t *TraditionalChineseLang) MsgThresholdP99RTT() string {
+	return "p99 RTT %.2fms 超過上限 %.2fms"
+}
+
+func (t *TraditionalChineseLang) MsgThresholdNoResponse() string {
+	return "沒有成功的回應"
+}
+
+func (t *TraditionalChineseLang) MsgVerdictPass() string {
+	return "判定: 通過\n"
+}
+
+func (t *TraditionalChineseLang) MsgVerdictFail() string {
+	return "判定: 未通過 (%s)\n"
+}
+
+// HTTP transfer
+func (t *TraditionalChineseLang) MsgStatisticsBodyData() string {
+	return "回應本文 (解壓縮後): %d bytes (%.2f MB)\n"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseHTTPTransfer() string {
+	return "    時間: 回應標頭 = %.2fms, 本文 = %.2fms, 總計 = %.2fms\n"
+}
+
+// Throughput test
+func (t *TraditionalChineseLang) OptThroughput() string {
+	return "以持續傳輸測量 HTTP 吞吐量，取代健康檢查請求"
+}
+
+func (t *TraditionalChineseLang) OptUploadSize() string {
+	return "上傳指定大小 (如 10M) 的產生資料，取代下載"
+}
+
+func (t *TraditionalChineseLang) OptStreams() string {
+	return "平行傳輸流數量 (預設 1)"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputDownload() string {
+	return "下載"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputUpload() string {
+	return "上傳"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputStart() string {
+	return "吞吐量測試 (%s, %d 個傳輸流)，每 %s 取樣一次\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputSample() string {
+	return "  %7.2fs  %10.2f Mbps  (%d bytes)\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputStreamFailed() string {
+	return "  傳輸流 %d 失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputStreamDone() string {
+	return "  傳輸流 %d: %d bytes，耗時 %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputStatisticsTitle() string {
+	return "\n--- HTTP 吞吐量統計 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputStreams() string {
+	return "傳輸流 = %d, 完成 = %d, 失敗 = %d\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputTransferred() string {
+	return "傳輸量 (%s): %d bytes (%.2f MB)，耗時 %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputRampUp() string {
+	return "爬升時間: %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputSustained() string {
+	return "持續吞吐量: 最小 = %.2f Mbps, 最大 = %.2f Mbps, 平均 = %.2f Mbps\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputFinal() string {
+	return "最終吞吐量: %.2f Mbps\n"
+}
+
+func (t *TraditionalChineseLang) MsgThroughputTTFB() string {
+	return "首位元組時間: 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// HTTP connection reuse
+func (t *TraditionalChineseLang) OptHTTPReuse() string {
+	return "在 HTTP 探測之間重複使用 keep-alive 連線 (預設)"
+}
+
+func (t *TraditionalChineseLang) OptHTTPFresh() string {
+	return "每次 HTTP 探測都建立新的 TCP/TLS 連線"
+}
+
+func (t *TraditionalChineseLang) ErrorRequiresHTTPMode() string {
+	return "%s 需要 HTTP 模式 (-H)"
+}
+
+func (t *TraditionalChineseLang) ErrorHTTPReuseFresh() string {
+	return "--http-reuse 與 --http-fresh 不能同時使用"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseHTTPConnReused() string {
+	return "    連線: 重複使用, 本機連接埠 %d, 閒置 %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseHTTPConnNew() string {
+	return "    連線: 新建, 本機連接埠 %d, 連線 = %.2fms, TLS 交握 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsReusedConns() string {
+	return "重複使用連線: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsNewConns() string {
+	return "新建連線: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// HTTP protocol version
+func (t *TraditionalChineseLang) OptHTTP2() string {
+	return "強制使用 HTTP/2 (https 透過 ALPN 協商 h2，http 使用 h2c prior knowledge)"
+}
+
+func (t *TraditionalChineseLang) OptHTTP3() string {
+	return "使用基於 QUIC 的 HTTP/3 (僅限 https)"
+}
+
+func (t *TraditionalChineseLang) ErrorHTTP2HTTP3() string {
+	return "--http2 與 --http3 不能同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorHTTP3RequiresHTTPS() string {
+	return "--http3 需要 https:// URI"
+}
+
+// HTTP redirects
+func (t *TraditionalChineseLang) OptFollow() string {
+	return "跟隨 HTTP 重新導向並回報最終回應"
+}
+
+func (t *TraditionalChineseLang) OptMaxRedirs() string {
+	return "最多跟隨的重新導向次數 (預設 10)"
+}
+
+func (t *TraditionalChineseLang) ErrorRedirectDowngrade() string {
+	return "重新導向由 https 降級為 http: %v -> %v"
+}
+
+func (t *TraditionalChineseLang) ErrorRedirectLoop() string {
+	return "偵測到重新導向迴圈: %v"
+}
+
+func (t *TraditionalChineseLang) ErrorTooManyRedirects() string {
+	return "已重新導向 %d 次，停止跟隨"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseHTTPRedirect() string {
+	return "    重新導向 %d: %d %s -> %s (%.2fms)\n"
+}
+
+// TLS options
+func (t *TraditionalChineseLang) OptCACert() string {
+	return "信任此 PEM 檔案中的 CA 憑證，取代系統根憑證"
+}
+
+func (t *TraditionalChineseLang) OptCert() string {
+	return "雙向 TLS 使用的用戶端憑證 (PEM)"
+}
+
+func (t *TraditionalChineseLang) OptKey() string {
+	return "--cert 的私鑰 (PEM) (預設從 --cert 檔案讀取)"
+}
+
+func (t *TraditionalChineseLang) OptTLSMin() string {
+	return "最低 TLS 版本 (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (t *TraditionalChineseLang) OptTLSMax() string {
+	return "最高 TLS 版本 (1.0, 1.1, 1.2, 1.3)"
+}
+
+func (t *TraditionalChineseLang) OptCiphers() string {
+	return "TLS 1.2 及以下允許的加密套件 (以逗號分隔)"
+}
+
+func (t *TraditionalChineseLang) OptSNI() string {
+	return "TLS 交握時送出並用於驗證憑證的伺服器名稱"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSVersionRange() string {
+	return "--tls-min 不能高於 --tls-max"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSLoadCACert() string {
+	return "無法讀取 CA 憑證檔案 %s: %w"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSNoCACerts() string {
+	return "%s 中找不到 PEM 憑證"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSKeyWithoutCert() string {
+	return "--key 需要搭配 --cert"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSLoadClientCert() string {
+	return "無法載入用戶端憑證: %w"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSUnknownAuthority() string {
+	return "憑證由未知的憑證機構簽發 (簽發者: %s)，可使用 --cacert 信任"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSHostname() string {
+	return "憑證對 %s 無效 (有效名稱: %s)"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSExpired() string {
+	return "憑證已過期或尚未生效 (有效期間 %s 至 %s)"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSInvalidCert() string {
+	return "憑證無效: %v"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSNotTLS() string {
+	return "伺服器未以 TLS 回應 (是否為 http:// 連接埠?)"
+}
+
+func (t *TraditionalChineseLang) ErrorTLSRemoteAlert() string {
+	return "伺服器拒絕了 TLS 交握: %v"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseHTTPTLS() string {
+	return "    TLS: %s, %s, 伺服器名稱 %s\n"
+}
+
+// Proxy
+func (t *TraditionalChineseLang) OptProxy() string {
+	return "透過 HTTP CONNECT 或 SOCKS5 代理連線 (支援 http://、socks5://、socks5h://、user:pass@)"
+}
+
+func (t *TraditionalChineseLang) OptProxyEnv() string {
+	return "使用 HTTP_PROXY/HTTPS_PROXY 指定的代理，並遵循 NO_PROXY"
+}
+
+func (t *TraditionalChineseLang) ErrorProxyURL() string {
+	return "無效的代理位址: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorProxyScheme() string {
+	return "不支援的代理協定 %q (請使用 http、socks5 或 socks5h)"
+}
+
+func (t *TraditionalChineseLang) ErrorProxyHTTP3() string {
+	return "--http3 無法透過代理使用"
+}
+
+func (t *TraditionalChineseLang) ErrorProxyDial() string {
+	return "無法連線到代理 %v: %v"
+}
+
+func (t *TraditionalChineseLang) ErrorProxyConnect() string {
+	return "代理拒絕了 CONNECT: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorSOCKSProtocol() string {
+	return "代理傳回無效的 SOCKS5 回應"
+}
+
+func (t *TraditionalChineseLang) ErrorSOCKSNoMethod() string {
+	return "SOCKS5 代理要求不支援的驗證方式"
+}
+
+func (t *TraditionalChineseLang) ErrorSOCKSAuth() string {
+	return "SOCKS5 代理驗證失敗"
+}
+
+func (t *TraditionalChineseLang) ErrorSOCKSReply() string {
+	return "SOCKS5 代理無法連線到目標: %s"
+}
+
+func (t *TraditionalChineseLang) MsgViaProxy() string {
+	return "使用代理 %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgTCPPingStartProxy() string {
+	return "正在對 %s 連接埠 %s 執行 TCP Ping (位址由代理解析)\n"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseHTTPProxy() string {
+	return "    代理: %s, 連線代理 = %.2fms, 建立通道 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsProxyConnect() string {
+	return "連線代理: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsProxyTunnel() string {
+	return "到目標的通道: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// Unix domain sockets
+func (t *TraditionalChineseLang) OptUnixSocket() string {
+	return "透過此 Unix 網域通訊端傳送 HTTP 探測 (URI 僅提供路徑與 Host)"
+}
+
+func (t *TraditionalChineseLang) ErrorUnixSocketConflict() string {
+	return "Unix 網域通訊端不能與 --proxy 或 --http3 同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorUnixTargetPort() string {
+	return "unix: 目標不能指定連接埠"
+}
+
+func (t *TraditionalChineseLang) MsgUnixPingStart() string {
+	return "正在連線 Unix 網域通訊端 %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgViaUnixSocket() string {
+	return "使用 Unix 網域通訊端 %s\n"
+}
+
+// WebSocket
+func (t *TraditionalChineseLang) OptWebSocket() string {
+	return "WebSocket 模式: 與 ws:// 或 wss:// URI 交握後測量 ping/pong 往返時間"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithWebSocket() string {
+	return "%s 不能與 --ws 同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorWSRequiresURI() string {
+	return "WebSocket 模式需要提供 URI\n\n用法: tcping --ws [選項] <ws://...|wss://...>\n請執行 'tcping -h' 取得更多資訊"
+}
+
+func (t *TraditionalChineseLang) ErrorWSURI() string {
+	return "WebSocket URI 必須以 ws:// 或 wss:// 開頭: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorWSHandshakeStatus() string {
+	return "WebSocket 交握被拒絕: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorWSHandshakeInvalid() string {
+	return "無效的 WebSocket 交握回應"
+}
+
+func (t *TraditionalChineseLang) ErrorWSProtocol() string {
+	return "WebSocket 協定錯誤: 控制訊框過大"
+}
+
+func (t *TraditionalChineseLang) ErrorWSClosed() string {
+	return "伺服器關閉了連線 (代碼 %d %s)"
+}
+
+func (t *TraditionalChineseLang) ErrorWSPongTimeout() string {
+	return "%dms 內沒有收到 pong"
+}
+
+func (t *TraditionalChineseLang) MsgWSPingStart() string {
+	return "正在對 %s 執行 WebSocket ping\n"
+}
+
+func (t *TraditionalChineseLang) MsgWSFailed() string {
+	return "WebSocket %s: seq=%d 失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseWSHandshake() string {
+	return "  交握: 總計 = %.2fms, 連線 = %.2fms, TLS 交握 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgWSStatisticsTitle() string {
+	return "\n\n--- WebSocket ping 統計 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsWSHandshake() string {
+	return "交握: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsWSReconnects() string {
+	return "重新連線: %d\n"
+}
+
+// gRPC
+func (t *TraditionalChineseLang) OptGRPC() string {
+	return "gRPC 模式: 呼叫 host:port 的 grpc.health.v1.Health/Check，SERVING 視為成功"
+}
+
+func (t *TraditionalChineseLang) OptGRPCService() string {
+	return "gRPC 模式下檢查的服務名稱 (預設: 整個伺服器)"
+}
+
+func (t *TraditionalChineseLang) ErrorRequiresGRPC() string {
+	return "%s 需要與 --grpc 一起使用"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithGRPC() string {
+	return "%s 不能與 --grpc 同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorGRPCRequiresTarget() string {
+	return "gRPC 模式需要提供目標\n\n用法: tcping --grpc [選項] <host:port>\n請執行 'tcping -h' 取得更多資訊"
+}
+
+func (t *TraditionalChineseLang) ErrorGRPCTarget() string {
+	return "無效的 gRPC 目標 (應為 host:port): %s"
+}
+
+func (t *TraditionalChineseLang) ErrorGRPCResponse() string {
+	return "無效的健康檢查回應"
+}
+
+func (t *TraditionalChineseLang) ErrorGRPCStatus() string {
+	return "grpc-status %s (%s): %s"
+}
+
+func (t *TraditionalChineseLang) ErrorGRPCHTTPStatus() string {
+	return "非預期的 HTTP 狀態 %s"
+}
+
+func (t *TraditionalChineseLang) MsgGRPCPingStart() string {
+	return "正在對 %s 執行 gRPC 健康檢查\n"
+}
+
+func (t *TraditionalChineseLang) MsgGRPCService() string {
+	return "服務: %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgGRPCFailed() string {
+	return "gRPC %s: seq=%d 失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgGRPCStatisticsTitle() string {
+	return "\n\n--- gRPC 健康檢查統計 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsGRPCStatus() string {
+	return "狀態 %s: %d\n"
+}
+
+// Application protocols
+func (t *TraditionalChineseLang) OptProto() string {
+	return "連線後進行應用層協定交握: redis, mysql, postgres, memcached, mongodb, smtp, ssh, ftp"
+}
+
+func (t *TraditionalChineseLang) ErrorProtoTCPOnly() string {
+	return "--proto 只能在 TCP 模式下使用"
+}
+
+func (t *TraditionalChineseLang) ErrorProtoUnexpected() string {
+	return "非預期的回應: %s"
+}
+
+func (t *TraditionalChineseLang) MsgProtoFailed() string {
+	return "%s: seq=%d 已在 %.2fms 內連線，但 %s 交握失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsProto() string {
+	return "應用層交握: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+// DNS
+func (t *TraditionalChineseLang) OptDNS() string {
+	return "DNS 模式: 向目標伺服器傳送 DNS 查詢 (預設埠 53，dot 為 853)"
+}
+
+func (t *TraditionalChineseLang) OptDNSName() string {
+	return "DNS 模式下查詢的名稱 (預設: .)"
+}
+
+func (t *TraditionalChineseLang) OptDNSType() string {
+	return "查詢的記錄類型，如 A、AAAA、MX (預設: NS)"
+}
+
+func (t *TraditionalChineseLang) OptDNSTransport() string {
+	return "DNS 傳輸方式: udp、tcp 或 dot (DNS over TLS) (預設: udp)"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithDNS() string {
+	return "%s 不能與 --dns 同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorDNSName() string {
+	return "無效的 DNS 名稱: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorDNSReply() string {
+	return "無效的 DNS 回應"
+}
+
+func (t *TraditionalChineseLang) MsgDNSPingStart() string {
+	return "正在對 %s (%s - %s) 埠 %s 執行 DNS 查詢: %s %s (%s)\n"
+}
+
+func (t *TraditionalChineseLang) MsgDNSFailed() string {
+	return "DNS %s: seq=%d 失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseDNSConn() string {
+	return "  連線: 連線 = %.2fms, TLS 交握 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgDNSStatisticsTitle() string {
+	return "\n\n--- DNS 查詢統計 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsDNSRcode() string {
+	return "回應碼 %s: %d\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsDNSTruncated() string {
+	return "被截斷的回應: %d\n"
+}
+
+// ICMP
+func (t *TraditionalChineseLang) OptICMP() string {
+	return "使用非特權 ping 通訊端的 ICMP 回顯模式 (Linux)"
+}
+
+func (t *TraditionalChineseLang) OptCompareICMP() string {
+	return "每次 TCP 探測後傳送 ICMP 回顯並比較往返時間"
+}
+
+func (t *TraditionalChineseLang) ErrorICMPNotPermitted() string {
+	return "目前使用者不允許使用 ping 通訊端，可以這樣允許使用者群組: sysctl -w net.ipv4.ping_group_range=\"0 2147483647\""
+}
+
+func (t *TraditionalChineseLang) ErrorICMPUnsupported() string {
+	return "--icmp 需要 Linux 的非特權 ping 通訊端，此平台不支援"
+}
+
+func (t *TraditionalChineseLang) ErrorICMPPort() string {
+	return "--icmp 不能指定埠"
+}
+
+func (t *TraditionalChineseLang) ErrorICMPAddress() string {
+	return "無效的 ICMP 目標位址: %s"
+}
+
+func (t *TraditionalChineseLang) ErrorICMPTimeout() string {
+	return "%dms 內沒有收到回顯應答"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithICMP() string {
+	return "%s 不能與 --icmp 或 --compare-icmp 同時使用"
+}
+
+func (t *TraditionalChineseLang) MsgICMPPingStart() string {
+	return "正在對 %s (%s - %s) 執行 ICMP Ping\n"
+}
+
+func (t *TraditionalChineseLang) MsgICMPCompareUnavailable() string {
+	return "已停用 ICMP 比較: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgICMPFailed() string {
+	return "ICMP %s: seq=%d 失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgICMPStatisticsTitle() string {
+	return "\n\n--- ICMP Ping 統計 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgICMPCompareTitle() string {
+	return "\nTCP 與 ICMP 對比:\n"
+}
+
+func (t *TraditionalChineseLang) MsgICMPCompareRow() string {
+	return "  %-4s 已傳送 = %d, 已接收 = %d (%.1f%% 遺失), 最小/平均/最大 = %.2f/%.2f/%.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgICMPCompareDiff() string {
+	return "  平均 TCP - ICMP = %+.2fms\n"
+}
+
+func (t *TraditionalChineseLang) ErrorICMPUnixTarget() string {
+	return "--icmp 和 --compare-icmp 不能用於 unix: 目標"
+}
+
+// NTP
+func (t *TraditionalChineseLang) OptNTP() string {
+	return "NTP 模式: 傳送 SNTP 請求並報告時鐘偏移 (預設埠 123)"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithNTP() string {
+	return "%s 不能與 --ntp 同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorNTPKissCode() string {
+	return "伺服器拒絕了請求 (kiss 代碼 %s)"
+}
+
+func (t *TraditionalChineseLang) ErrorNTPUnsynchronized() string {
+	return "伺服器時鐘未同步"
+}
+
+func (t *TraditionalChineseLang) ErrorNTPReply() string {
+	return "無效的 NTP 回應"
+}
+
+func (t *TraditionalChineseLang) MsgNTPPingStart() string {
+	return "正在查詢 NTP 伺服器 %s (%s - %s) 埠 %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgNTPFailed() string {
+	return "NTP %s: seq=%d 失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseNTP() string {
+	return "  伺服器: 閏秒指示 = %d, 根延遲 = %.2fms, 根離散 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgNTPStatisticsTitle() string {
+	return "\n\n--- NTP 查詢統計 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsNTPOffset() string {
+	return "時鐘偏移: 最小 = %+.3fms, 最大 = %+.3fms, 平均 = %+.3fms\n"
+}
+
+// Persistent connection
+func (t *TraditionalChineseLang) OptPersistent() string {
+	return "保持一個 TCP 連線並測量其上請求/應答的往返時間"
+}
+
+func (t *TraditionalChineseLang) OptSend() string {
+	return "每次探測傳送的資料 (可使用 \\r\\n 等跳脫序列，預設: 回顯行)"
+}
+
+func (t *TraditionalChineseLang) OptExpect() string {
+	return "應答必須包含的資料 (預設: 任意應答)"
+}
+
+func (t *TraditionalChineseLang) ErrorRequiresPersistent() string {
+	return "%s 需要與 --persistent 或 --find-idle-timeout 一起使用"
+}
+
+func (t *TraditionalChineseLang) ErrorExpectRequiresSend() string {
+	return "--expect 需要與 --send 一起使用"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithPersistent() string {
+	return "%s 不能與 --persistent 同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorPersistentTimeout() string {
+	return "%dms 內沒有收到應答"
+}
+
+func (t *TraditionalChineseLang) MsgPersistentPingStart() string {
+	return "正在保持到 %s 的持久連線\n"
+}
+
+func (t *TraditionalChineseLang) MsgPersistentConnectFailed() string {
+	return "TCP %s: seq=%d 連線失敗: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgPersistentDropped() string {
+	return "TCP %s: seq=%d 連線中斷: %v (已連線 %v，閒置 %v)\n"
+}
+
+func (t *TraditionalChineseLang) MsgPersistentStatisticsTitle() string {
+	return "\n\n--- 持久連線統計 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsPersistentConnect() string {
+	return "連線: %d, 最小 = %.2fms, 最大 = %.2fms, 平均 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsPersistentDrops() string {
+	return "中斷: %d, 重新連線: %d\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsPersistentLifetime() string {
+	return "中斷連線的存續時間: 最小 = %.1fs, 最大 = %.1fs, 平均 = %.1fs\n"
+}
+
+// Idle timeout discovery
+func (t *TraditionalChineseLang) OptFindIdleTimeout() string {
+	return "探測路徑上閒置連線能保持多久 (NAT/防火牆逾時)"
+}
+
+func (t *TraditionalChineseLang) OptIdleMin() string {
+	return "測試的最短閒置時間及搜尋精度 (預設: 1s)"
+}
+
+func (t *TraditionalChineseLang) OptIdleMax() string {
+	return "測試的最長閒置時間 (預設: 10m)"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithFindIdle() string {
+	return "%s 不能與 --find-idle-timeout 同時使用"
+}
+
+func (t *TraditionalChineseLang) ErrorIdleRange() string {
+	return "--idle-min 必須大於 0 且不大於 --idle-max"
+}
+
+func (t *TraditionalChineseLang) MsgIdleSearchStart() string {
+	return "正在搜尋 %s 的閒置逾時，範圍 %v 到 %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleWaiting() string {
+	return "seq=%d: 保持新連線閒置 %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleAlive() string {
+	return "TCP %s: seq=%d 閒置=%v 存活 time=%.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleDropped() string {
+	return "TCP %s: seq=%d 閒置=%v 已中斷: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleSetupFailed() string {
+	return "TCP %s: seq=%d 目標不可用: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleStatisticsTitle() string {
+	return "\n\n--- 閒置逾時 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleRange() string {
+	return "閒置連線在超過 %v、不超過 %v 後被中斷\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleBelow() string {
+	return "閒置連線在 %v 內被中斷\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleNone() string {
+	return "在 %v 內沒有閒置逾時\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleAtLeast() string {
+	return "閒置連線至少能保持 %v (搜尋未完成)\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleAborted() string {
+	return "搜尋已中止: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleChecks() string {
+	return "檢查: %d, 目標不可用: %d\n"
+}
+
+// Load mode
+func (t *TraditionalChineseLang) OptRate() string {
+	return "不等待先前的探測，以固定速率發起探測，如 100/s 或 600/m"
+}
+
+func (t *TraditionalChineseLang) OptConcurrency() string {
+	return "同時進行的探測上限；未指定 --rate 時以此數量連續探測"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithLoad() string {
+	return "%s 不能與 --rate 或 --concurrency 同時使用"
+}
+
+func (t *TraditionalChineseLang) MsgLoadStart() string {
+	return "負載模式: 每秒 %.1f 次探測，最多同時進行 %d 次\n"
+}
+
+func (t *TraditionalChineseLang) MsgLoadStartUnlimited() string {
+	return "負載模式: 不限速，同時進行 %d 次探測\n"
+}
+
+func (t *TraditionalChineseLang) MsgLoadTitle() string {
+	return "\n--- 負載 ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgLoadTarget() string {
+	return "目標: 每秒 %.1f 次探測，並行 %d\n"
+}
+
+func (t *TraditionalChineseLang) MsgLoadUnlimited() string {
+	return "目標: 不限速，並行 %d\n"
+}
+
+func (t *TraditionalChineseLang) MsgLoadAchieved() string {
+	return "已發起: %d 次，耗時 %.1f 秒，實際每秒 %.1f 次探測，因並行上限略過: %d\n"
+}
+
+func (t *TraditionalChineseLang) MsgLoadLatency() string {
+	return "延遲: p50 %.2fms, p90 %.2fms, p99 %.2fms, p99.9 %.2fms, 最大 %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgLoadErrors() string {
+	return "錯誤: %s\n"
+}
+
+// Fixed-cadence scheduling
+func (t *TraditionalChineseLang) OptIntervalJitter() string {
+	return "每次探測隨機延後不超過此值的時間，如 200ms (不大於間隔)"
+}
+
+func (t *TraditionalChineseLang) OptMaxInFlight() string {
+	return "探測耗時超過間隔時同時進行的上限 (預設: 逾時 / 間隔)"
+}
+
+func (t *TraditionalChineseLang) ErrorJitterRange() string {
+	return "--interval-jitter 不能大於間隔"
+}
+
+func (t *TraditionalChineseLang) ErrorNotWithMaxInFlight() string {
+	return "%s 只能逐次探測，不能與 --max-inflight 同時使用"
+}
+
+func (t *TraditionalChineseLang) MsgScheduleSkipped() string {
+	return "已略過 %d 個探測時間點: 仍有 %d 次探測在進行\n"
+}
+
+func (t *TraditionalChineseLang) MsgScheduleLate() string {
+	return "seq=%d 比計劃晚 %v 發出 (同時進行上限: %d)\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsSchedule() string {
+	return "排程: 延後發出 %d 次，略過 %d 個時間點\n"
+}
+
+// Adaptive timeout and interval
+func (t *TraditionalChineseLang) OptAdaptive() string {
+	return "依觀測到的 RTT 決定逾時，失敗後縮短探測間隔"
+}
+
+func (t *TraditionalChineseLang) MsgVerboseAdaptive() string {
+	return "  自適應: seq=%d 逾時=%v 間隔=%v srtt=%v rttvar=%v\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsAdaptiveTimeout() string {
+	return "自適應逾時: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgStatisticsAdaptiveInterval() string {
+	return "自適應間隔: 最小 = %.0fms, 最大 = %.0fms, 平均 = %.0fms\n"
+}
+
+// Recording and analyze
+func (t *TraditionalChineseLang) UsageAnalyze() string {
+	return "tcping analyze [選項] <檔案>...                 # 分析記錄的結果"
+}
+
+func (t *TraditionalChineseLang) OptRecord() string {
+	return "將每次探測結果追加寫入 JSON Lines 檔案，供 tcping analyze 使用"
+}
+
+func (t *TraditionalChineseLang) OptAnalyzeFrom() string {
+	return "只分析此時間及之後的記錄"
+}
+
+func (t *TraditionalChineseLang) OptAnalyzeTo() string {
+	return "只分析此時間之前的記錄"
+}
+
+func (t *TraditionalChineseLang) OptAnalyzeMerge() string {
+	return "合併所有檔案中同一目標的記錄"
+}
+
+func (t *TraditionalChineseLang) OptAnalyzeJSON() string {
+	return "以 JSON 輸出分析結果"
+}
+
+func (t *TraditionalChineseLang) ErrorRecordOpen() string {
+	return "無法開啟記錄檔案 %s: %v"
+}
+
+func (t *TraditionalChineseLang) ErrorRecordWrite() string {
+	return "寫入記錄檔案 %s 失敗: %v"
+}
+
+func (t *TraditionalChineseLang) ErrorRecordParse() string {
+	return "%s:%d: 無效的記錄: %v"
+}
+
+func (t *TraditionalChineseLang) ErrorRecordTime() string {
+	return "無效的時間 %q，請使用 RFC 3339 或 2006-01-02 15:04:05 格式"
+}
+
+func (t *TraditionalChineseLang) ErrorAnalyzeNoFiles() string {
+	return "tcping analyze 需要至少一個記錄檔案"
+}
+
+func (t *TraditionalChineseLang) ErrorAnalyzeNoRecords() string {
+	return "所選時間範圍內沒有記錄"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzeTitle() string {
+	return "\n--- %s ---\n"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzeFiles() string {
+	return "檔案: %s\n"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzeRange() string {
+	return "記錄: %d 筆, %s - %s (%v)\n"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzePercentiles() string {
+	return "百分位數: P50 = %.2fms, P90 = %.2fms, P99 = %.2fms, P99.9 = %.2fms\n"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzeOutages() string {
+	return "中斷: %d 次\n"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzeOutageOpen() string {
+	return "記錄結束"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzeCompareTitle() string {
+	return "\n比較:\n"
+}
+
+func (t *TraditionalChineseLang) MsgAnalyzeCompareRow() string {
+	return "  %s: 已傳送 = %d, 遺失 %.1f%%, P50/P99 = %.2f/%.2fms, 中斷 = %d\n"
+}
+
+// Dashboard conflicts
+func (t *TraditionalChineseLang) ErrorNotWithTUI() string {
+	return "%s 不能與 --tui 同時使用"
+}
+
+// Idle timeout search: inconclusive checks
+func (t *TraditionalChineseLang) MsgIdleTimedOut() string {
+	return "TCP %s: seq=%d 閒置=%v 無法判定: %v\n"
+}
+
+func (t *TraditionalChineseLang) MsgIdleInconclusive() string {
+	return "閒置 %v 後請求逾時，未收到重設或關閉，無法確定連線是否已中斷，搜尋已停止；增大 -w 可以區分應答緩慢和靜默丟棄\n"
 }
\ No newline at end of file
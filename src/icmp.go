@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// ICMP 回显请求和应答的类型
+const (
+	icmpEchoReply     = 0
+	icmpEchoRequest   = 8
+	icmpv6EchoRequest = 128
+	icmpv6EchoReply   = 129
+)
+
+// icmpPinger 在一个 ping 套接字上发送回显请求。
+// 内核会把标识符改写为套接字的端口号并只投递属于本套接字的应答，因此只需要匹配序号
+type icmpPinger struct {
+	conn net.PacketConn
+	dst  *net.UDPAddr
+	ipv6 bool
+}
+
+func newICMPPinger(ip string) (*icmpPinger, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, fmt.Errorf(i18n.T().ErrorICMPAddress(), ip)
+	}
+	ipv6 := addr.To4() == nil
+	conn, err := listenICMP(ipv6)
+	if err != nil {
+		return nil, err
+	}
+	return &icmpPinger{conn: conn, dst: &net.UDPAddr{IP: addr}, ipv6: ipv6}, nil
+}
+
+func (p *icmpPinger) close() {
+	p.conn.Close()
+}
+
+// icmpChecksum 计算 RFC 1071 校验和
+func icmpChecksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(b[i])<<8 | uint32(b[i+1])
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum)
+}
+
+// echo 发送序号为 seq 的回显请求并等待应答，返回往返时间（毫秒）
+func (p *icmpPinger) echo(ctx context.Context, seq int, timeout time.Duration) (float64, error) {
+	msgType, replyType := byte(icmpEchoRequest), byte(icmpEchoReply)
+	if p.ipv6 {
+		msgType, replyType = icmpv6EchoRequest, icmpv6EchoReply
+	}
+	// 类型、代码、校验和、标识符（由内核填写）、序号，随后是时间戳负载
+	msg := []byte{msgType, 0, 0, 0, 0, 0}
+	msg = binary.BigEndian.AppendUint16(msg, uint16(seq))
+	msg = binary.BigEndian.AppendUint64(msg, uint64(time.Now().UnixNano()))
+	if !p.ipv6 {
+		// ICMPv6 的校验和包含伪首部，由内核计算
+		binary.BigEndian.PutUint16(msg[2:], icmpChecksum(msg))
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	p.conn.SetReadDeadline(time.Now().Add(timeout))
+	stop := context.AfterFunc(ctx, func() { p.conn.SetReadDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	start := time.Now()
+	if _, err := p.conn.WriteTo(msg, p.dst); err != nil {
+		return 0, err
+	}
+	buf := make([]byte, 1500)
+	for {
+		n, _, err := p.conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return durationMillis(time.Since(start)), ctx.Err()
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				return durationMillis(time.Since(start)), fmt.Errorf(i18n.T().ErrorICMPTimeout(), timeout.Milliseconds())
+			}
+			return durationMillis(time.Since(start)), err
+		}
+		// 忽略之前超时的请求迟到的应答
+		if n >= 8 && buf[0] == replyType && binary.BigEndian.Uint16(buf[6:]) == uint16(seq) {
+			return durationMillis(time.Since(start)), nil
+		}
+	}
+}
+
+// icmpPingOnce 是 --icmp 模式的单次探测
+func icmpPingOnce(ctx context.Context, p *icmpPinger, stats *Statistics, seq int, ip string, opts *Options) probeResult {
+	result := probeResult{seq: seq, time: time.Now()}
+	elapsed, err := p.echo(ctx, seq, probeTimeout(ctx, opts))
+	if errors.Is(ctx.Err(), context.Canceled) {
+		fmt.Fprint(output, infoText(i18n.T().MsgOperationCanceled(), opts.ColorOutput))
+		result.canceled = true
+		return result
+	}
+	stats.update(elapsed, err == nil)
+	result.rtt = elapsed
+	if err != nil {
+		fmt.Fprint(output, errorText(fmt.Sprintf(i18n.T().MsgICMPFailed(), ip, seq, err), opts.ColorOutput))
+		result.err = err.Error()
+		return result
+	}
+	result.success = true
+	fmt.Fprint(output, successText(fmt.Sprintf("ICMP %s: seq=%d time=%.2fms\n", ip, seq, elapsed), opts.ColorOutput))
+	return result
+}
+
+// compareICMP 在 TCP 探测之后向同一主机发送一次回显请求，输出两者的往返时间差
+func compareICMP(ctx context.Context, p *icmpPinger, tcp probeResult, stats *Statistics, ip string, opts *Options) {
+	elapsed, err := p.echo(ctx, tcp.seq, probeTimeout(ctx, opts))
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return
+	}
+	stats.updateICMPCompare(elapsed, err == nil)
+	if err != nil {
+		fmt.Fprint(output, errorText(fmt.Sprintf("  "+i18n.T().MsgICMPFailed(), ip, tcp.seq, err), opts.ColorOutput))
+		return
+	}
+	line := fmt.Sprintf("  ICMP %s: seq=%d time=%.2fms", ip, tcp.seq, elapsed)
+	if tcp.success {
+		line += fmt.Sprintf(" tcp-icmp=%+.2fms", tcp.rtt-elapsed)
+	}
+	fmt.Fprint(output, successText(line+"\n", opts.ColorOutput))
+}
+
+// updateICMPCompare 记录 --compare-icmp 中一次回显请求的结果
+func (s *Statistics) updateICMPCompare(elapsed float64, ok bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.icmpSent++
+	if ok {
+		s.icmpRTT.add(elapsed)
+	}
+}
+
+// getICMPCompare 返回 --compare-icmp 的发送次数和往返时间统计
+func (s *Statistics) getICMPCompare() (int64, latencyGroup) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.icmpSent, s.icmpRTT
+}
+
+// printICMPStatistics 输出 --icmp 模式的统计
+func printICMPStatistics(stats *Statistics) {
+	lang := i18n.T()
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Print(lang.MsgICMPStatisticsTitle())
+	if sent == 0 {
+		return
+	}
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Printf(lang.MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+}
+
+// printICMPComparison 把 TCP 和 ICMP 的往返时间并列输出
+func printICMPComparison(stats *Statistics) {
+	lang := i18n.T()
+	icmpSent, icmp := stats.getICMPCompare()
+	if icmpSent == 0 {
+		return
+	}
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Print(lang.MsgICMPCompareTitle())
+	row := func(name string, sent, received int64, min, avg, max float64) {
+		loss := float64(sent-received) / float64(sent) * 100
+		fmt.Printf(lang.MsgICMPCompareRow(), name, sent, received, loss, min, avg, max)
+	}
+	row("TCP", sent, responded, minTime, avgTime, maxTime)
+	row("ICMP", icmpSent, icmp.count, icmp.min, icmp.avg(), icmp.max)
+	if responded > 0 && icmp.count > 0 {
+		fmt.Printf(lang.MsgICMPCompareDiff(), avgTime-icmp.avg())
+	}
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package main
+
+import (
+	"errors"
+	"net"
+
+	"tcping/src/i18n"
+)
+
+// listenICMP 在没有非特权 ping 套接字的平台上始终失败
+func listenICMP(ipv6 bool) (net.PacketConn, error) {
+	return nil, errors.New(i18n.T().ErrorICMPUnsupported())
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestICMPChecksum(t *testing.T) {
+	// RFC 1071 中的示例数据
+	data := []byte{0x00, 0x01, 0xf2, 0x03, 0xf4, 0xf5, 0xf6, 0xf7}
+	if got := icmpChecksum(data); got != ^uint16(0xddf2) {
+		t.Errorf("icmpChecksum = %#04x, want %#04x", got, ^uint16(0xddf2))
+	}
+	// 包含校验和的报文再次计算结果为 0
+	msg := []byte{8, 0, 0, 0, 0, 0, 0, 7, 'x'}
+	sum := icmpChecksum(msg)
+	msg[2], msg[3] = byte(sum>>8), byte(sum)
+	if got := icmpChecksum(msg); got != 0 {
+		t.Errorf("checksum over a checksummed message = %#04x, want 0", got)
+	}
+}
+
+// loopbackPinger 打开到 127.0.0.1 的 ping 套接字，系统不允许时跳过测试
+func loopbackPinger(t *testing.T) *icmpPinger {
+	t.Helper()
+	p, err := newICMPPinger("127.0.0.1")
+	if err != nil {
+		t.Skipf("ping sockets unavailable: %v", err)
+	}
+	t.Cleanup(p.close)
+	return p
+}
+
+func TestICMPPingOnce(t *testing.T) {
+	p := loopbackPinger(t)
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	for seq := 0; seq < 3; seq++ {
+		r := icmpPingOnce(context.Background(), p, stats, seq, "127.0.0.1", &Options{Timeout: 2000})
+		if !r.success {
+			t.Fatalf("seq %d failed: %s", seq, r.err)
+		}
+	}
+	if !strings.Contains(buf.String(), "ICMP 127.0.0.1: seq=2 time=") {
+		t.Errorf("output %q", buf.String())
+	}
+	if sent, responded, _, _, _ := stats.getStats(); sent != 3 || responded != 3 {
+		t.Errorf("sent/responded = %d/%d, want 3/3", sent, responded)
+	}
+}
+
+func TestCompareICMP(t *testing.T) {
+	p := loopbackPinger(t)
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	stats.update(1.5, true)
+	compareICMP(context.Background(), p, probeResult{seq: 0, success: true, rtt: 1.5}, stats, "127.0.0.1", &Options{Timeout: 2000})
+	if !strings.Contains(buf.String(), "  ICMP 127.0.0.1: seq=0") || !strings.Contains(buf.String(), "tcp-icmp=") {
+		t.Errorf("output %q", buf.String())
+	}
+	if sent, icmp := stats.getICMPCompare(); sent != 1 || icmp.count != 1 {
+		t.Errorf("icmp sent/received = %d/%d, want 1/1", sent, icmp.count)
+	}
+	if s := buildJSONSummary("tcp", "127.0.0.1:80", stats, stopNone, verdict{}); s.ICMP == nil || s.ICMP.RTT == nil {
+		t.Errorf("JSON summary lacks the ICMP comparison: %+v", s.ICMP)
+	}
+}
+
+func TestICMPEchoCanceled(t *testing.T) {
+	p := loopbackPinger(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := p.echo(ctx, 1, 5*time.Second); !errors.Is(err, context.Canceled) {
+		t.Errorf("echo after cancellation = %v, want context.Canceled", err)
+	}
+}
+
+func TestValidateICMPOptions(t *testing.T) {
+	for _, opts := range []*Options{
+		{ICMP: true, HTTPMode: true},
+		{ICMP: true, CompareICMP: true},
+		{ICMP: true, Proto: "redis"},
+		{CompareICMP: true, DNS: true},
+		{CompareICMP: true, Proxy: "socks5://p"},
+	} {
+		if err := validateModes(opts); err == nil {
+			t.Errorf("%+v should be rejected", *opts)
+		}
+	}
+	if err := validateModes(&Options{CompareICMP: true, Proto: "redis"}); err != nil {
+		t.Errorf("--compare-icmp with --proto rejected: %v", err)
+	}
+	if _, _, err := validateOptions(&Options{ICMP: true}, []string{"example.com", "443"}); err == nil {
+		t.Error("--icmp with a port should be rejected")
+	}
+	if host, port, err := validateOptions(&Options{ICMP: true}, []string{"example.com"}); err != nil || host != "example.com" || port != "" {
+		t.Errorf("validateOptions = %q, %q, %v", host, port, err)
+	}
+}
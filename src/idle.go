@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// --find-idle-timeout 搜索范围的默认值
+const (
+	defaultIdleMin = time.Second
+	defaultIdleMax = 10 * time.Minute
+)
+
+// idleResult 是空闲超时搜索的结果
+type idleResult struct {
+	alive        time.Duration // 确认连接仍然可用的最长空闲时间
+	dropped      time.Duration // 确认连接已被断开的最短空闲时间，0 表示尚未观察到断开
+	inconclusive time.Duration // 请求超时、无法判断连接是否被静默丢弃的空闲时间，搜索随即停止
+	checks       int
+	complete     bool  // 搜索是否正常结束，被中断或出错时为 false
+	err          error // 目标无法连接或不响应时中止搜索的原因
+}
+
+// idleCheck 建立一个新连接，完成一次请求/应答后保持空闲 idle，再检查连接是否仍然可用。
+// setupErr 表示目标本身不可用，dropErr 表示空闲后的请求失败：连接被重置或关闭，
+// 或者超时 (persistentTimeout)
+func (p *persistentProber) idleCheck(ctx context.Context, seq int, idle time.Duration) (rtt float64, dropErr, setupErr error) {
+	timeout := time.Duration(p.opts.Timeout) * time.Millisecond
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	conn, _, err := p.dial(dialCtx)
+	cancel()
+	if err != nil {
+		return 0, nil, err
+	}
+	p.conn = conn
+	defer p.close()
+	if _, rtt, err = p.roundTrip(ctx, seq, timeout); err != nil {
+		return rtt, nil, err
+	}
+
+	timer := time.NewTimer(idle)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return 0, nil, ctx.Err()
+	case <-timer.C:
+	}
+	_, rtt, err = p.roundTrip(ctx, seq, timeout)
+	return rtt, err, nil
+}
+
+// idleResolution 返回搜索结束时允许的区间宽度：不小于 --idle-min，且约为已知下限的 5%
+func idleResolution(lo, min time.Duration) time.Duration {
+	if r := lo / 20; r > min {
+		return r
+	}
+	return min
+}
+
+// findIdleTimeout 搜索路径上的空闲超时：先从 --idle-min 开始逐次加倍空闲时间，
+// 直到连接被断开或达到 --idle-max，再在最后一次存活和第一次断开之间二分查找
+func findIdleTimeout(ctx context.Context, p *persistentProber, stats *Statistics, onResult func(probeResult)) *idleResult {
+	lang := i18n.T()
+	opts := p.opts
+	res := &idleResult{}
+
+	// check 返回连接在空闲 idle 后是否存活，ok 为 false 时中止搜索
+	check := func(idle time.Duration) (alive, ok bool) {
+		seq := res.checks
+		res.checks++
+		fmt.Fprintf(output, lang.MsgIdleWaiting(), seq, idle)
+		result := probeResult{seq: seq, time: time.Now()}
+		rtt, dropErr, setupErr := p.idleCheck(ctx, seq, idle)
+		if errors.Is(ctx.Err(), context.Canceled) {
+			fmt.Fprint(output, infoText(lang.MsgOperationCanceled(), opts.ColorOutput))
+			return false, false
+		}
+		result.rtt = rtt
+		var timeout persistentTimeout
+		switch {
+		case setupErr != nil:
+			stats.update(rtt, false)
+			fmt.Fprint(output, errorText(fmt.Sprintf(lang.MsgIdleSetupFailed(), p.endpoint, seq, setupErr), opts.ColorOutput))
+			res.err = setupErr
+			result.err = setupErr.Error()
+		case errors.As(dropErr, &timeout):
+			// 超时可能是连接被静默丢弃，也可能只是应答缓慢，不作为断开参与搜索
+			fmt.Fprint(output, infoText(fmt.Sprintf(lang.MsgIdleTimedOut(), p.endpoint, seq, idle, dropErr), opts.ColorOutput))
+			res.inconclusive = idle
+			result.err = dropErr.Error()
+			onResult(result)
+			return false, false
+		case dropErr != nil:
+			// 断开是搜索要观察的结果，不计入丢包
+			fmt.Fprint(output, infoText(fmt.Sprintf(lang.MsgIdleDropped(), p.endpoint, seq, idle, dropErr), opts.ColorOutput))
+			result.err = dropErr.Error()
+		default:
+			stats.update(rtt, true)
+			fmt.Fprint(output, successText(fmt.Sprintf(lang.MsgIdleAlive(), p.endpoint, seq, idle, rtt), opts.ColorOutput))
+			result.success = true
+		}
+		onResult(result)
+		return dropErr == nil, setupErr == nil
+	}
+
+	for idle := opts.IdleMin; res.dropped == 0; idle *= 2 {
+		if idle > opts.IdleMax {
+			idle = opts.IdleMax
+		}
+		alive, ok := check(idle)
+		if !ok {
+			return res
+		}
+		if !alive {
+			res.dropped = idle
+			break
+		}
+		res.alive = idle
+		if idle == opts.IdleMax {
+			res.complete = true
+			return res
+		}
+	}
+	for res.dropped-res.alive > idleResolution(res.alive, opts.IdleMin) {
+		mid := (res.alive + (res.dropped-res.alive)/2).Round(time.Millisecond)
+		alive, ok := check(mid)
+		if !ok {
+			return res
+		}
+		if alive {
+			res.alive = mid
+		} else {
+			res.dropped = mid
+		}
+	}
+	res.complete = true
+	return res
+}
+
+// printIdleTimeout 输出空闲超时搜索的结论和存活检查的往返时间
+func printIdleTimeout(res *idleResult, stats *Statistics) {
+	lang := i18n.T()
+	fmt.Print(lang.MsgIdleStatisticsTitle())
+	switch {
+	case res.err != nil:
+		fmt.Printf(lang.MsgIdleAborted(), res.err)
+	case res.dropped > 0 && res.alive > 0:
+		fmt.Printf(lang.MsgIdleRange(), res.alive, res.dropped)
+	case res.dropped > 0:
+		fmt.Printf(lang.MsgIdleBelow(), res.dropped)
+	case res.alive > 0 && res.complete:
+		fmt.Printf(lang.MsgIdleNone(), res.alive)
+	case res.alive > 0:
+		fmt.Printf(lang.MsgIdleAtLeast(), res.alive)
+	}
+	if res.inconclusive > 0 {
+		fmt.Printf(lang.MsgIdleInconclusive(), res.inconclusive)
+	}
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Printf(lang.MsgIdleChecks(), res.checks, sent-responded)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+}
+
+// json 将空闲超时搜索结果转换为 JSON 输出结构
+func (r *idleResult) json() *jsonIdleTimeout {
+	j := &jsonIdleTimeout{Checks: r.checks, Complete: r.complete}
+	if r.alive > 0 {
+		j.AliveMs = durationMillis(r.alive)
+	}
+	if r.dropped > 0 {
+		d := durationMillis(r.dropped)
+		j.DroppedMs = &d
+	}
+	if r.inconclusive > 0 {
+		j.InconclusiveMs = durationMillis(r.inconclusive)
+	}
+	if r.err != nil {
+		j.Error = r.err.Error()
+	}
+	return j
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// activityWriter 在每次写入时记录活动时间
+type activityWriter struct {
+	w    io.Writer
+	last *atomic.Int64
+}
+
+func (a activityWriter) Write(p []byte) (int, error) {
+	a.last.Store(time.Now().UnixNano())
+	return a.w.Write(p)
+}
+
+// startIdleKiller 启动一个转发到 target 的中继，连接空闲超过 limit 后被关闭，模拟 NAT 回收连接
+func startIdleKiller(t *testing.T, target string, limit time.Duration) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			client, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			server, err := net.Dial("tcp", target)
+			if err != nil {
+				client.Close()
+				continue
+			}
+			var last atomic.Int64
+			last.Store(time.Now().UnixNano())
+			go io.Copy(activityWriter{server, &last}, client)
+			go io.Copy(activityWriter{client, &last}, server)
+			go func() {
+				defer client.Close()
+				defer server.Close()
+				for time.Since(time.Unix(0, last.Load())) < limit {
+					time.Sleep(5 * time.Millisecond)
+				}
+			}()
+		}
+	}()
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	return port
+}
+
+// idleSearch 对 127.0.0.1:port 执行空闲超时搜索
+func idleSearch(t *testing.T, port string, opts *Options) (*idleResult, *Statistics, string) {
+	t.Helper()
+	opts.FindIdleTimeout = true
+	opts.Timeout = 500
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	p := newPersistentProber("127.0.0.1", port, "127.0.0.1:"+port, opts)
+	res := findIdleTimeout(context.Background(), p, stats, func(probeResult) {})
+	return res, stats, buf.String()
+}
+
+func TestFindIdleTimeout(t *testing.T) {
+	echo := startSessionServer(t, func(conn net.Conn) { io.Copy(conn, conn) })
+	port := startIdleKiller(t, "127.0.0.1:"+echo, 330*time.Millisecond)
+
+	res, stats, out := idleSearch(t, port, &Options{IdleMin: 50 * time.Millisecond, IdleMax: 5 * time.Second})
+	if !res.complete || res.err != nil {
+		t.Fatalf("search incomplete: %+v, output %q", res, out)
+	}
+	// 加倍阶段：50、100、200ms 存活，400ms 断开；二分阶段：300ms 存活，350ms 断开
+	if res.alive != 300*time.Millisecond || res.dropped != 350*time.Millisecond {
+		t.Errorf("alive/dropped = %v/%v, want 300ms/350ms; output %q", res.alive, res.dropped, out)
+	}
+	if !strings.Contains(out, "idle=400ms dropped") {
+		t.Errorf("output %q should report the dropped connection", out)
+	}
+	// 断开是搜索的结果，不计为丢包
+	if sent, responded, _, _, _ := stats.getStats(); sent != responded || sent != 4 {
+		t.Errorf("sent/responded = %d/%d, want 4/4", sent, responded)
+	}
+}
+
+func TestFindIdleTimeoutNone(t *testing.T) {
+	echo := startSessionServer(t, func(conn net.Conn) { io.Copy(conn, conn) })
+	res, _, _ := idleSearch(t, echo, &Options{IdleMin: 20 * time.Millisecond, IdleMax: 50 * time.Millisecond})
+	if !res.complete || res.dropped != 0 || res.alive != 50*time.Millisecond || res.checks != 3 {
+		t.Errorf("result = %+v, want alive up to 50ms after 3 checks", res)
+	}
+	if j := res.json(); j.DroppedMs != nil || j.AliveMs != 50 {
+		t.Errorf("json = %+v", j)
+	}
+}
+
+func TestFindIdleTimeoutInconclusive(t *testing.T) {
+	// 空闲超过 80ms 后不再应答，但也不关闭连接，模拟静默丢弃或应答缓慢
+	server := startSessionServer(t, func(conn net.Conn) {
+		buf := make([]byte, 512)
+		last := time.Now()
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return
+			}
+			if time.Since(last) < 80*time.Millisecond {
+				conn.Write(buf[:n])
+			}
+			last = time.Now()
+		}
+	})
+	res, stats, out := idleSearch(t, server, &Options{IdleMin: 50 * time.Millisecond, IdleMax: time.Second})
+	if res.complete || res.err != nil || res.dropped != 0 {
+		t.Fatalf("result = %+v, want a search stopped without a drop; output %q", res, out)
+	}
+	if res.alive != 50*time.Millisecond || res.inconclusive != 100*time.Millisecond || res.checks != 2 {
+		t.Errorf("result = %+v, want alive 50ms and inconclusive 100ms after 2 checks", res)
+	}
+	if !strings.Contains(out, "idle=100ms inconclusive") {
+		t.Errorf("output %q should report the timed-out check as inconclusive", out)
+	}
+	if sent, responded, _, _, _ := stats.getStats(); sent != 1 || responded != 1 {
+		t.Errorf("sent/responded = %d/%d, want 1/1", sent, responded)
+	}
+	if j := res.json(); j.DroppedMs != nil || j.InconclusiveMs != 100 {
+		t.Errorf("json = %+v", j)
+	}
+}
+
+func TestFindIdleTimeoutTargetDown(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	ln.Close()
+	res, stats, _ := idleSearch(t, port, &Options{IdleMin: 10 * time.Millisecond, IdleMax: time.Second})
+	if res.err == nil || res.complete || res.checks != 1 {
+		t.Errorf("result = %+v, want an aborted search", res)
+	}
+	if v := evaluateVerdict(stats, &Options{MaxLoss: -1}, stopNone); v.exitCode != exitTotalLoss {
+		t.Errorf("exit code = %d, want %d", v.exitCode, exitTotalLoss)
+	}
+}
+
+func TestValidateFindIdleOptions(t *testing.T) {
+	if err := validateModes(&Options{FindIdleTimeout: true, IdleMin: time.Minute, IdleMax: time.Second}); err == nil {
+		t.Error("--idle-min above --idle-max should be rejected")
+	}
+	if err := validateModes(&Options{FindIdleTimeout: true, Persistent: true, IdleMin: time.Second, IdleMax: time.Minute}); err == nil {
+		t.Error("--persistent with --find-idle-timeout should be rejected")
+	}
+	if err := validateModes(&Options{FindIdleTimeout: true, Send: "PING\r\n", IdleMin: time.Second, IdleMax: time.Minute}); err != nil {
+		t.Errorf("--send with --find-idle-timeout rejected: %v", err)
+	}
+}
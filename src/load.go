@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// loadReportInterval 是负载模式输出区间统计的间隔
+const loadReportInterval = time.Second
+
+// loadSampleLimit 是负载模式保留的耗时样本数上限，超过后按蓄水池抽样，
+// 高速率下长时间运行时内存占用不再增长
+const loadSampleLimit = 100000
+
+// parseRate 解析 --rate，接受 100、100/s 和 6000/m 三种写法，返回每秒次数
+func parseRate(s string) (float64, error) {
+	num, unit, _ := strings.Cut(strings.TrimSpace(s), "/")
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil || v <= 0 || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid rate %q", s)
+	}
+	switch unit {
+	case "", "s":
+		return v, nil
+	case "m", "min":
+		return v / 60, nil
+	}
+	return 0, fmt.Errorf("invalid rate unit %q", unit)
+}
+
+// errorClass 把探测错误归类为常见的 errno 名称，便于按类型统计
+func errorClass(msg string) string {
+	classes := []struct{ pattern, name string }{
+		{"connection refused", "ECONNREFUSED"},
+		{"connection reset", "ECONNRESET"},
+		{"cannot assign requested address", "EADDRNOTAVAIL"},
+		{"too many open files", "EMFILE"},
+		{"no route to host", "EHOSTUNREACH"},
+		{"network is unreachable", "ENETUNREACH"},
+		{"timeout", "timeout"},
+		{"deadline exceeded", "timeout"},
+	}
+	lower := strings.ToLower(msg)
+	for _, c := range classes {
+		if strings.Contains(lower, c.pattern) {
+			return c.name
+		}
+	}
+	return "other"
+}
+
+// loadInterval 是负载模式一个报告区间内的统计
+type loadInterval struct {
+	at       time.Duration // 区间结束时距开始的时间
+	length   time.Duration
+	launched int64
+	ok       int64
+	failed   int64
+	p50, p99 float64
+	errors   map[string]int64
+}
+
+// loadResult 是负载模式的整体结果
+type loadResult struct {
+	rate        float64 // 目标速率（每秒），0 表示不限速
+	concurrency int
+	launched    int64
+	skipped     int64 // 达到并发上限而未能按计划发出的探测
+	elapsed     time.Duration
+	errors      map[string]int64
+	intervals   []loadInterval
+	reason      stopReason
+}
+
+// loadConcurrency 返回并发上限。限速时默认按速率和超时估算，保证探测不因并发上限被跳过
+func loadConcurrency(opts *Options) int {
+	if opts.Concurrency > 0 {
+		return opts.Concurrency
+	}
+	return int(math.Max(1, math.Ceil(opts.Rate*float64(opts.Timeout)/1000)))
+}
+
+// runLoad 以开环方式按 --rate 发出探测，不等待之前的探测完成，同时在途的探测不超过 --concurrency；
+// 只指定 --concurrency 时由相应数量的工作协程连续探测。每秒向 report 输出一行区间统计
+func runLoad(ctx context.Context, opts *Options, probe func(ctx context.Context, seq int) probeResult,
+	onResult func(probeResult), report io.Writer) *loadResult {
+	res := &loadResult{rate: opts.Rate, concurrency: loadConcurrency(opts), errors: make(map[string]int64)}
+	start := time.Now()
+	stop := newStopTracker(opts, start)
+	schedCtx, stopScheduling := context.WithCancel(ctx)
+	defer stopScheduling()
+
+	var mu sync.Mutex
+	window := loadInterval{errors: make(map[string]int64)}
+	var samples []float64
+	var inFlight int64
+	// finish 记录一次探测的结果，满足停止条件时停止发出新的探测
+	finish := func(r probeResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.canceled {
+			return
+		}
+		if r.success {
+			window.ok++
+			samples = append(samples, r.rtt)
+		} else {
+			class := errorClass(r.err)
+			window.failed++
+			window.errors[class]++
+			res.errors[class]++
+		}
+		onResult(r)
+		if reason := stop.check(r); reason != stopNone && res.reason == stopNone {
+			res.reason = reason
+			stopScheduling()
+		}
+	}
+	// expired 检查 --duration，到达时停止发出新的探测
+	expired := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if stop.expired(time.Now()) {
+			if res.reason == stopNone {
+				res.reason = stopDuration
+			}
+			return true
+		}
+		return false
+	}
+	// flush 结束当前区间并输出一行统计
+	lastFlush := start
+	flush := func(now time.Time) {
+		mu.Lock()
+		defer mu.Unlock()
+		if now.Sub(lastFlush) <= 0 {
+			return
+		}
+		sort.Float64s(samples)
+		window.at, window.length = now.Sub(start), now.Sub(lastFlush)
+		window.p50, window.p99 = percentile(samples, 50), percentile(samples, 99)
+		res.intervals = append(res.intervals, window)
+		fmt.Fprint(report, formatLoadInterval(window, atomic.LoadInt64(&inFlight)))
+		window = loadInterval{errors: make(map[string]int64)}
+		samples = samples[:0]
+		lastFlush = now
+	}
+	launched := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		res.launched++
+		window.launched++
+	}
+
+	reporterDone := make(chan struct{})
+	go func() {
+		defer close(reporterDone)
+		ticker := time.NewTicker(loadReportInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-schedCtx.Done():
+				return
+			case now := <-ticker.C:
+				flush(now)
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	run := func(seq int) {
+		atomic.AddInt64(&inFlight, 1)
+		finish(probe(ctx, seq))
+		atomic.AddInt64(&inFlight, -1)
+	}
+	if opts.Rate > 0 {
+		sem := make(chan struct{}, res.concurrency)
+		period := time.Duration(float64(time.Second) / opts.Rate)
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		next := start
+	schedule:
+		for seq := 0; opts.Count == 0 || seq < opts.Count; seq++ {
+			// 按固定节拍发出，落后时立即补发而不是顺延；时长限制先到达时提前结束
+			wait := time.Until(next)
+			if remaining, ok := stop.untilDeadline(time.Now()); ok && remaining < wait {
+				wait = remaining
+			}
+			timer.Reset(wait)
+			select {
+			case <-schedCtx.Done():
+				break schedule
+			case <-timer.C:
+			}
+			if expired() {
+				break
+			}
+			select {
+			case sem <- struct{}{}:
+				launched()
+				wg.Add(1)
+				go func(seq int) {
+					defer wg.Done()
+					defer func() { <-sem }()
+					run(seq)
+				}(seq)
+			default:
+				mu.Lock()
+				res.skipped++
+				mu.Unlock()
+			}
+			next = next.Add(period)
+		}
+	} else {
+		var nextSeq int64 = -1
+		for w := 0; w < res.concurrency; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					seq := int(atomic.AddInt64(&nextSeq, 1))
+					if (opts.Count != 0 && seq >= opts.Count) || schedCtx.Err() != nil || expired() {
+						return
+					}
+					launched()
+					run(seq)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	stopScheduling()
+	<-reporterDone
+	if ctx.Err() == nil || window.launched > 0 {
+		flush(time.Now())
+	}
+	res.elapsed = time.Since(start)
+	return res
+}
+
+// formatErrorCounts 按次数从多到少输出错误类型，如 " ECONNREFUSED=3 timeout=1"
+func formatErrorCounts(counts map[string]int64) string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if counts[names[i]] != counts[names[j]] {
+			return counts[names[i]] > counts[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%d", name, counts[name])
+	}
+	return b.String()
+}
+
+// formatLoadInterval 格式化一行区间统计
+func formatLoadInterval(w loadInterval, inFlight int64) string {
+	rate := float64(w.launched) / w.length.Seconds()
+	line := fmt.Sprintf("[%7.1fs] launched=%d ok=%d failed=%d inflight=%d rate=%.1f/s",
+		w.at.Seconds(), w.launched, w.ok, w.failed, inFlight, rate)
+	if w.ok > 0 {
+		line += fmt.Sprintf(" p50=%.2fms p99=%.2fms", w.p50, w.p99)
+	}
+	return line + formatErrorCounts(w.errors) + "\n"
+}
+
+// printLoadSummary 输出负载模式的速率、并发、延迟分布和错误分类
+func printLoadSummary(res *loadResult, stats *Statistics) {
+	lang := i18n.T()
+	fmt.Print(lang.MsgLoadTitle())
+	if res.rate > 0 {
+		fmt.Printf(lang.MsgLoadTarget(), res.rate, res.concurrency)
+	} else {
+		fmt.Printf(lang.MsgLoadUnlimited(), res.concurrency)
+	}
+	achieved := 0.0
+	if res.elapsed > 0 {
+		achieved = float64(res.launched) / res.elapsed.Seconds()
+	}
+	fmt.Printf(lang.MsgLoadAchieved(), res.launched, res.elapsed.Seconds(), achieved, res.skipped)
+	if _, responded, _, maxTime, _ := stats.getStats(); responded > 0 {
+		p := stats.getPercentiles(50, 90, 99, 99.9)
+		fmt.Printf(lang.MsgLoadLatency(), p[0], p[1], p[2], p[3], maxTime)
+	}
+	if len(res.errors) > 0 {
+		fmt.Printf(lang.MsgLoadErrors(), strings.TrimSpace(formatErrorCounts(res.errors)))
+	}
+}
+
+// json 将负载模式结果转换为 JSON 输出结构
+func (r *loadResult) json() *jsonLoad {
+	j := &jsonLoad{
+		TargetRate:  r.rate,
+		Concurrency: r.concurrency,
+		Launched:    r.launched,
+		Skipped:     r.skipped,
+		DurationMs:  durationMillis(r.elapsed),
+		Errors:      r.errors,
+		Intervals:   []jsonLoadInterval{},
+	}
+	if r.elapsed > 0 {
+		j.AchievedRate = float64(r.launched) / r.elapsed.Seconds()
+	}
+	for _, w := range r.intervals {
+		j.Intervals = append(j.Intervals, jsonLoadInterval{
+			AtMs: durationMillis(w.at), Launched: w.launched, OK: w.ok, Failed: w.failed,
+			P50: w.p50, P99: w.p99, Errors: w.errors,
+		})
+	}
+	return j
+}
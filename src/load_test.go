@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float64
+		ok   bool
+	}{
+		{"100", 100, true},
+		{"100/s", 100, true},
+		{"600/m", 10, true},
+		{"0.5/s", 0.5, true},
+		{"0", 0, false},
+		{"-5", 0, false},
+		{"5/h", 0, false},
+		{"fast", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := parseRate(tt.in)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("parseRate(%q) = %v, %v", tt.in, got, err)
+		}
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := map[string]string{
+		"dial tcp 127.0.0.1:1: connect: connection refused":              "ECONNREFUSED",
+		"dial tcp 10.0.0.1:80: i/o timeout":                              "timeout",
+		"Get http://10.0.0.1/: context deadline exceeded":                "timeout",
+		"dial tcp 10.0.0.1:80: connect: cannot assign requested address": "EADDRNOTAVAIL",
+		"dial tcp 10.0.0.1:80: socket: too many open files":              "EMFILE",
+		"read tcp: connection reset by peer":                             "ECONNRESET",
+		"something else":                                                 "other",
+	}
+	for msg, want := range tests {
+		if got := errorClass(msg); got != want {
+			t.Errorf("errorClass(%q) = %s, want %s", msg, got, want)
+		}
+	}
+}
+
+// sleepProbe 返回耗时 d 的模拟探测，并记录同时进行的最大探测数
+func sleepProbe(d time.Duration, maxInFlight *int64) func(ctx context.Context, seq int) probeResult {
+	var inFlight int64
+	return func(ctx context.Context, seq int) probeResult {
+		n := atomic.AddInt64(&inFlight, 1)
+		defer atomic.AddInt64(&inFlight, -1)
+		for {
+			m := atomic.LoadInt64(maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt64(maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(d)
+		return probeResult{seq: seq, success: true, rtt: durationMillis(d), time: time.Now()}
+	}
+}
+
+func TestRunLoadRate(t *testing.T) {
+	var maxInFlight, results int64
+	opts := &Options{Rate: 100, Count: 60, Timeout: 1000}
+	var report strings.Builder
+	res := runLoad(context.Background(), opts, sleepProbe(30*time.Millisecond, &maxInFlight),
+		func(probeResult) { atomic.AddInt64(&results, 1) }, &report)
+	if res.launched != 60 || results != 60 || res.skipped != 0 {
+		t.Fatalf("launched/results/skipped = %d/%d/%d, want 60/60/0", res.launched, results, res.skipped)
+	}
+	// 60 次探测按 100/s 发出约需 0.6 秒，且不等待前一次探测完成
+	if res.elapsed < 550*time.Millisecond || res.elapsed > 1500*time.Millisecond {
+		t.Errorf("elapsed = %v, want about 600ms", res.elapsed)
+	}
+	if maxInFlight < 2 {
+		t.Errorf("max in flight = %d, probes should overlap", maxInFlight)
+	}
+	if len(res.intervals) == 0 || !strings.Contains(report.String(), "launched=") {
+		t.Errorf("report %q should contain interval lines", report.String())
+	}
+	var total int64
+	for _, w := range res.intervals {
+		total += w.launched
+	}
+	if total != 60 {
+		t.Errorf("intervals launched = %d, want 60", total)
+	}
+}
+
+func TestRunLoadSkipsAtConcurrencyLimit(t *testing.T) {
+	var maxInFlight int64
+	opts := &Options{Rate: 200, Concurrency: 1, Count: 20, Timeout: 1000}
+	res := runLoad(context.Background(), opts, sleepProbe(40*time.Millisecond, &maxInFlight),
+		func(probeResult) {}, io.Discard)
+	if maxInFlight != 1 {
+		t.Errorf("max in flight = %d, want 1", maxInFlight)
+	}
+	if res.skipped == 0 || res.launched+res.skipped != 20 {
+		t.Errorf("launched/skipped = %d/%d, want skipped slots", res.launched, res.skipped)
+	}
+}
+
+func TestRunLoadConcurrency(t *testing.T) {
+	var maxInFlight int64
+	opts := &Options{Concurrency: 3, Count: 30, Timeout: 1000}
+	res := runLoad(context.Background(), opts, sleepProbe(5*time.Millisecond, &maxInFlight),
+		func(probeResult) {}, io.Discard)
+	if res.launched != 30 || maxInFlight != 3 {
+		t.Errorf("launched/max in flight = %d/%d, want 30/3", res.launched, maxInFlight)
+	}
+}
+
+func TestRunLoadStopCondition(t *testing.T) {
+	opts := &Options{Rate: 100, MaxFailures: 3, Timeout: 1000}
+	probe := func(ctx context.Context, seq int) probeResult {
+		return probeResult{seq: seq, err: "dial tcp: connect: connection refused"}
+	}
+	res := runLoad(context.Background(), opts, probe, func(probeResult) {}, io.Discard)
+	if res.reason != stopMaxFailures {
+		t.Errorf("reason = %v, want max-failures", res.reason)
+	}
+	if res.errors["ECONNREFUSED"] < 3 {
+		t.Errorf("errors = %v", res.errors)
+	}
+}
+
+func TestRunLoadCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	var maxInFlight int64
+	start := time.Now()
+	res := runLoad(ctx, &Options{Rate: 50, Timeout: 1000}, sleepProbe(10*time.Millisecond, &maxInFlight),
+		func(probeResult) {}, io.Discard)
+	if time.Since(start) > time.Second || res.reason != stopNone || res.launched == 0 {
+		t.Errorf("canceled run: launched=%d reason=%v after %v", res.launched, res.reason, time.Since(start))
+	}
+}
+
+func TestLoadTCPConnect(t *testing.T) {
+	port := startSessionServer(t, func(conn net.Conn) {})
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, closed, _ := net.SplitHostPort(ln.Addr().String())
+	ln.Close()
+
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	for _, tt := range []struct {
+		port  string
+		ok    bool
+		class string
+	}{
+		{port, true, ""},
+		{closed, false, "ECONNREFUSED"},
+	} {
+		stats := &Statistics{}
+		opts := &Options{Rate: 200, Count: 40, Timeout: 1000}
+		probe := func(ctx context.Context, seq int) probeResult {
+			return pingOnce(ctx, "127.0.0.1", tt.port, opts.Timeout, stats, seq, "127.0.0.1", opts)
+		}
+		res := runLoad(context.Background(), opts, probe, func(probeResult) {}, io.Discard)
+		sent, responded, _, _, _ := stats.getStats()
+		if sent != 40 {
+			t.Errorf("port %s: sent = %d, want 40", tt.port, sent)
+		}
+		if tt.ok && responded != 40 {
+			t.Errorf("port %s: responded = %d, want 40", tt.port, responded)
+		}
+		if !tt.ok && res.errors[tt.class] != 40 {
+			t.Errorf("port %s: errors = %v, want 40 %s", tt.port, res.errors, tt.class)
+		}
+	}
+}
+
+func TestValidateLoadOptions(t *testing.T) {
+	for _, opts := range []*Options{
+		{Rate: 10, HTTPMode: true},
+		{Rate: 10, Persistent: true},
+		{Concurrency: 4, ICMP: true},
+		{Rate: 10, SummaryEvery: time.Second},
+	} {
+		if err := validateModes(opts); err == nil || !strings.Contains(err.Error(), "--rate") {
+			t.Errorf("%+v: %v", opts, err)
+		}
+	}
+	if err := validateModes(&Options{Rate: 10, DNS: true, DNSTransport: dnsUDP}); err != nil {
+		t.Errorf("--rate with --dns rejected: %v", err)
+	}
+
+	opts := &Options{}
+	if _, err := setupFlags(opts, []string{"--rate", "600/m", "--concurrency", "5", "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if opts.Rate != 10 || opts.Concurrency != 5 || opts.Count != 0 {
+		t.Errorf("rate/concurrency/count = %v/%d/%d, want 10/5/0", opts.Rate, opts.Concurrency, opts.Count)
+	}
+	if loadConcurrency(&Options{Rate: 100, Timeout: 1500}) != 150 {
+		t.Error("default concurrency should cover rate × timeout")
+	}
+}
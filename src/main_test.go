@@ -1,6 +1,8 @@
 package main
 
 import (
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -268,3 +270,65 @@ func TestStatisticsConcurrentUpdateHTTP(t *testing.T) {
 		t.Errorf("totalBytes = %d, want %d", totalBytes, expectedBytes)
 	}
 }
+
+func TestStatisticsPercentile(t *testing.T) {
+	var s Statistics
+	if s.getPercentile(50) != 0 {
+		t.Error("percentile without samples should be 0")
+	}
+	for _, v := range []float64{50, 10, 40, 20, 30} {
+		s.update(v, true)
+	}
+	s.update(1000, false)
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 10},
+		{50, 30},
+		{90, 50},
+		{99, 50},
+		{100, 50},
+	}
+	for _, tt := range tests {
+		if got := s.getPercentile(tt.p); got != tt.want {
+			t.Errorf("getPercentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := s.getPercentiles(50, 90, 100); got[0] != 30 || got[1] != 50 || got[2] != 50 {
+		t.Errorf("getPercentiles = %v, want [30 50 50]", got)
+	}
+}
+
+func TestStatisticsSampleLimit(t *testing.T) {
+	s := Statistics{sampleLimit: 2000}
+	for i := 0; i < 100000; i++ {
+		s.update(float64(i%1000), true)
+	}
+	if len(s.samples) != 2000 {
+		t.Fatalf("samples = %d, want capped at 2000", len(s.samples))
+	}
+	// 抽样保留的样本覆盖整个运行期间，百分位接近全部样本的值
+	p := s.getPercentiles(50, 90)
+	if math.Abs(p[0]-500) > 50 || math.Abs(p[1]-900) > 50 {
+		t.Errorf("percentiles = %v, want about [500 900]", p)
+	}
+	if sent, responded, minTime, maxTime, _ := s.getStats(); sent != 100000 || responded != 100000 || minTime != 0 || maxTime != 999 {
+		t.Errorf("stats = %d/%d min %v max %v, counters should cover every probe", sent, responded, minTime, maxTime)
+	}
+}
+
+func TestPrintOptionTableAligns(t *testing.T) {
+	var b strings.Builder
+	printOptionTable(&b, []struct{ flags, desc string }{
+		{"-n, --count <count>", "count"},
+		{"    --interval-jitter <d>", "jitter"},
+	})
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("lines = %q", lines)
+	}
+	if strings.LastIndex(lines[1], "count") != strings.LastIndex(lines[2], "jitter") {
+		t.Errorf("descriptions not aligned:\n%s", b.String())
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// modeOptions 判断命令行中是否指定了某个选项，键为错误信息中显示的选项名
+var modeOptions = map[string]func(o *Options) bool{
+	"-H":                  func(o *Options) bool { return o.HTTPMode },
+	"--ws":                func(o *Options) bool { return o.WebSocket },
+	"--grpc":              func(o *Options) bool { return o.GRPC },
+	"--grpc-service":      func(o *Options) bool { return o.GRPCService != "" },
+	"--proto":             func(o *Options) bool { return o.Proto != "" },
+	"--dns":               func(o *Options) bool { return o.DNS },
+	"--ntp":               func(o *Options) bool { return o.NTP },
+	"--icmp":              func(o *Options) bool { return o.ICMP },
+	"--compare-icmp":      func(o *Options) bool { return o.CompareICMP },
+	"--persistent":        func(o *Options) bool { return o.Persistent },
+	"--find-idle-timeout": func(o *Options) bool { return o.FindIdleTimeout },
+	"--send":              func(o *Options) bool { return o.Send != "" },
+	"--expect":            func(o *Options) bool { return o.Expect != "" },
+	"--proxy":             func(o *Options) bool { return o.Proxy != "" },
+	"--proxy-env":         func(o *Options) bool { return o.ProxyEnv },
+	"--adaptive":          func(o *Options) bool { return o.Adaptive },
+	"--max-inflight":      func(o *Options) bool { return o.MaxInFlight > 0 },
+	"--interval-jitter":   func(o *Options) bool { return o.IntervalJitter > 0 },
+	"--summary-every":     func(o *Options) bool { return o.SummaryEvery > 0 },
+	"--summary-window":    func(o *Options) bool { return o.SummaryWindow },
+	"--throughput":        func(o *Options) bool { return o.Throughput },
+	"--upload-size":       func(o *Options) bool { return o.UploadSize > 0 },
+	"--http-reuse":        func(o *Options) bool { return o.HTTPReuse },
+	"--http-fresh":        func(o *Options) bool { return o.HTTPFresh },
+	"--http2":             func(o *Options) bool { return o.HTTP2 },
+	"--http3":             func(o *Options) bool { return o.HTTP3 },
+	"--follow":            func(o *Options) bool { return o.Follow },
+	"--unix-socket":       func(o *Options) bool { return o.UnixSocket != "" },
+	"--cacert":            func(o *Options) bool { return o.CACert != "" },
+	"--cert":              func(o *Options) bool { return o.Cert != "" },
+	"--key":               func(o *Options) bool { return o.Key != "" },
+	"--tls-min":           func(o *Options) bool { return o.TLSMin != 0 },
+	"--tls-max":           func(o *Options) bool { return o.TLSMax != 0 },
+	"--ciphers":           func(o *Options) bool { return o.Ciphers != nil },
+	"--sni":               func(o *Options) bool { return o.SNI != "" },
+}
+
+// modeRule 描述一种模式与选项的兼容性：active 成立时，options 中的任一选项都不能使用，
+// 错误信息由 message 给出
+type modeRule struct {
+	active  func(o *Options) bool
+	message func(lang i18n.Language) string
+	options []string
+}
+
+// modeRules 是全部模式的兼容性表，按顺序检查，报告第一个冲突的选项
+var modeRules = []modeRule{
+	{
+		func(o *Options) bool { return o.DNS },
+		i18n.Language.ErrorNotWithDNS,
+		[]string{"-H", "--ws", "--grpc", "--proto", "--proxy", "--proxy-env"},
+	},
+	{
+		func(o *Options) bool { return o.FindIdleTimeout },
+		i18n.Language.ErrorNotWithFindIdle,
+		[]string{"--persistent", "-H", "--ws", "--grpc", "--proto", "--dns", "--ntp", "--icmp", "--compare-icmp", "--adaptive"},
+	},
+	{
+		// 长连接模式只发送原始 TCP 数据
+		func(o *Options) bool { return o.Persistent },
+		i18n.Language.ErrorNotWithPersistent,
+		[]string{"-H", "--ws", "--grpc", "--proto", "--dns", "--ntp", "--icmp", "--compare-icmp"},
+	},
+	{
+		func(o *Options) bool { return !o.Persistent && !o.FindIdleTimeout },
+		i18n.Language.ErrorRequiresPersistent,
+		[]string{"--send", "--expect"},
+	},
+	{
+		func(o *Options) bool { return o.NTP },
+		i18n.Language.ErrorNotWithNTP,
+		[]string{"-H", "--ws", "--grpc", "--proto", "--dns", "--icmp", "--compare-icmp", "--proxy", "--proxy-env"},
+	},
+	{
+		// ICMP 直接发往目标主机，不能经过代理，也不能与其他探测模式混用
+		func(o *Options) bool { return o.ICMP },
+		i18n.Language.ErrorNotWithICMP,
+		[]string{"-H", "--ws", "--grpc", "--dns", "--proto", "--compare-icmp", "--proxy", "--proxy-env"},
+	},
+	{
+		// --compare-icmp 在 TCP 或 --proto 探测之外附加 ICMP 探测
+		func(o *Options) bool { return o.CompareICMP },
+		i18n.Language.ErrorNotWithICMP,
+		[]string{"-H", "--ws", "--grpc", "--dns", "--proxy", "--proxy-env"},
+	},
+	{
+		// 长连接、WebSocket、ICMP 和 HTTP/2、HTTP/3 探测共用一个连接或套接字，只能逐次进行
+		func(o *Options) bool { return o.MaxInFlight > 1 },
+		i18n.Language.ErrorNotWithMaxInFlight,
+		[]string{"--persistent", "--find-idle-timeout", "--ws", "--icmp", "--compare-icmp", "--adaptive",
+			"--http2", "--http3", "--throughput"},
+	},
+	{
+		// 负载模式只适用于互不依赖的单次探测。耗时样本按抽样保留，无法计算区间统计
+		func(o *Options) bool { return o.Rate > 0 || o.Concurrency > 0 },
+		i18n.Language.ErrorNotWithLoad,
+		[]string{"-H", "--ws", "--grpc", "--persistent", "--find-idle-timeout", "--icmp", "--compare-icmp",
+			"--summary-every", "--summary-window", "--interval-jitter", "--max-inflight", "--adaptive"},
+	},
+	{
+		// 仪表盘占用整个终端，阶段统计无处输出
+		func(o *Options) bool { return o.TUI },
+		i18n.Language.ErrorNotWithTUI,
+		[]string{"--summary-every"},
+	},
+	{
+		func(o *Options) bool { return !o.GRPC },
+		i18n.Language.ErrorRequiresGRPC,
+		[]string{"--grpc-service"},
+	},
+	{
+		// gRPC 模式固定使用 HTTP/2，可以使用 TLS、代理和 Unix 域套接字选项
+		func(o *Options) bool { return o.GRPC },
+		i18n.Language.ErrorNotWithGRPC,
+		[]string{"-H", "--ws", "--throughput", "--upload-size", "--http-fresh", "--http2", "--http3", "--follow"},
+	},
+	{
+		// WebSocket 模式共用连接、TLS 和代理相关选项，但不发送普通 HTTP 请求
+		func(o *Options) bool { return o.WebSocket },
+		i18n.Language.ErrorNotWithWebSocket,
+		[]string{"-H", "--throughput", "--upload-size", "--http-reuse", "--http-fresh", "--http2", "--http3", "--follow"},
+	},
+	{
+		func(o *Options) bool { return !o.HTTPMode && !o.WebSocket && !o.GRPC },
+		i18n.Language.ErrorRequiresHTTPMode,
+		[]string{"--throughput", "--upload-size", "--http-reuse", "--http-fresh", "--http2", "--http3", "--follow",
+			"--unix-socket"},
+	},
+	{
+		// DNS over TLS 可以使用 TLS 相关选项
+		func(o *Options) bool {
+			return !o.HTTPMode && !o.WebSocket && !o.GRPC && !(o.DNS && o.DNSTransport == dnsDoT)
+		},
+		i18n.Language.ErrorRequiresHTTPMode,
+		[]string{"--cacert", "--cert", "--key", "--tls-min", "--tls-max", "--ciphers", "--sni"},
+	},
+}
+
+// checkModeRules 按兼容性表检查模式和选项的组合
+func checkModeRules(opts *Options, rules []modeRule) error {
+	for _, rule := range rules {
+		if !rule.active(opts) {
+			continue
+		}
+		for _, name := range rule.options {
+			if modeOptions[name](opts) {
+				return fmt.Errorf(rule.message(i18n.T()), name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateModes 检查选项的取值范围，以及探测模式与选项的组合是否有效
+func validateModes(opts *Options) error {
+	lang := i18n.T()
+	if opts.HTTPReuse && opts.HTTPFresh {
+		return errors.New(lang.ErrorHTTPReuseFresh())
+	}
+	if opts.HTTP2 && opts.HTTP3 {
+		return errors.New(lang.ErrorHTTP2HTTP3())
+	}
+	if opts.MaxRedirs < 0 {
+		return &argError{kind: argErrInvalidValue, name: "--max-redirs", value: strconv.Itoa(opts.MaxRedirs)}
+	}
+	if opts.TLSMin != 0 && opts.TLSMax != 0 && opts.TLSMin > opts.TLSMax {
+		return errors.New(lang.ErrorTLSVersionRange())
+	}
+	if opts.Proto != "" && (opts.HTTPMode || opts.WebSocket || opts.GRPC) {
+		return errors.New(lang.ErrorProtoTCPOnly())
+	}
+	if err := checkModeRules(opts, modeRules); err != nil {
+		return err
+	}
+	if opts.FindIdleTimeout && (opts.IdleMin <= 0 || opts.IdleMin > opts.IdleMax) {
+		return errors.New(lang.ErrorIdleRange())
+	}
+	if opts.Expect != "" && opts.Send == "" {
+		return errors.New(lang.ErrorExpectRequiresSend())
+	}
+	if opts.IntervalJitter > time.Duration(opts.Interval)*time.Millisecond {
+		return errors.New(lang.ErrorJitterRange())
+	}
+	return nil
+}
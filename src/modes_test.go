@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestModeRulesUseKnownOptions(t *testing.T) {
+	for i, rule := range modeRules {
+		for _, name := range rule.options {
+			if modeOptions[name] == nil {
+				t.Errorf("rule %d: unknown option %q", i, name)
+			}
+		}
+	}
+}
+
+func TestCheckModeRules(t *testing.T) {
+	tests := []struct {
+		opts Options
+		want string // 错误信息中应包含的选项名，空表示有效
+	}{
+		{Options{DNS: true, HTTPMode: true}, "-H"},
+		{Options{FindIdleTimeout: true, Persistent: true}, "--persistent"},
+		{Options{Send: "ping"}, "--send"},
+		{Options{Persistent: true, Send: "ping"}, ""},
+		{Options{ICMP: true, Proto: "redis"}, "--proto"},
+		{Options{CompareICMP: true, Proto: "redis"}, ""},
+		{Options{MaxInFlight: 2, HTTPMode: true}, ""},
+		{Options{MaxInFlight: 2, HTTPMode: true, HTTP3: true}, "--http3"},
+		{Options{MaxInFlight: 1, Persistent: true}, ""},
+		{Options{Rate: 10, MaxInFlight: 1}, "--max-inflight"},
+		{Options{GRPCService: "svc"}, "--grpc-service"},
+		{Options{Follow: true}, "--follow"},
+		{Options{DNS: true, DNSTransport: dnsDoT, SNI: "dns.example"}, ""},
+		{Options{DNS: true, SNI: "dns.example"}, "--sni"},
+		{Options{WebSocket: true, SNI: "ws.example", UnixSocket: "/tmp/ws.sock"}, ""},
+		{Options{TUI: true, SummaryEvery: time.Minute}, "--summary-every"},
+		{Options{JSON: true, SummaryEvery: time.Minute}, ""},
+		{Options{Concurrency: 10, SummaryWindow: true}, "--summary-window"},
+	}
+	for _, tt := range tests {
+		err := checkModeRules(&tt.opts, modeRules)
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%+v: unexpected error %v", tt.opts, err)
+		case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("%+v: error = %v, want one naming %s", tt.opts, err, tt.want)
+		}
+	}
+}
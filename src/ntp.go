@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// ntpEpochOffset 是 NTP 纪元 (1900-01-01) 与 Unix 纪元之间的秒数
+const ntpEpochOffset = 2208988800
+
+// SNTP 报文 (RFC 4330) 的固定长度和模式
+const (
+	ntpPacketSize = 48
+	ntpModeClient = 3
+	ntpModeServer = 4
+	ntpVersion    = 4
+	ntpLeapAlarm  = 3 // 服务器时钟未同步
+)
+
+// ntpTime 把时间转换为 64 位 NTP 时间戳（32 位秒和 32 位小数）
+func ntpTime(t time.Time) uint64 {
+	secs := uint64(t.Unix() + ntpEpochOffset)
+	frac := uint64(t.Nanosecond()) << 32 / 1e9
+	return secs<<32 | frac
+}
+
+// ntpToTime 把 64 位 NTP 时间戳转换为时间
+func ntpToTime(ts uint64) time.Time {
+	secs := int64(ts>>32) - ntpEpochOffset
+	nanos := (ts & 0xffffffff) * 1e9 >> 32
+	return time.Unix(secs, int64(nanos))
+}
+
+// ntpShort 把 16.16 定点数的秒转换为毫秒
+func ntpShort(v uint32) float64 {
+	return float64(v) / 65536 * 1000
+}
+
+// ntpReply 是从服务器应答中读取的结果
+type ntpReply struct {
+	leap           int
+	stratum        int
+	refID          string
+	rootDelay      float64 // 毫秒
+	rootDispersion float64 // 毫秒
+	offset         float64 // 本地时钟与服务器时钟之差（毫秒），正值表示本地时钟落后
+	delay          float64 // 往返延迟（毫秒），不含服务器处理时间
+}
+
+// ntpRefID 按层级解释参考标识：1 级为 ASCII 时钟源名称，更高层级为上游服务器的 IPv4 地址
+func ntpRefID(id []byte, stratum int) string {
+	if stratum <= 1 {
+		return strings.TrimRight(string(id), "\x00")
+	}
+	return net.IP(id).String()
+}
+
+// parseNTPReply 检查应答并计算时钟偏移和往返延迟。
+// origin 为请求中的发送时间戳，与应答的起始时间戳不符时返回 ok=false；
+// sent 和 received 为本地发送和接收时间，elapsed 为两者间的单调时钟耗时
+func parseNTPReply(msg []byte, origin uint64, sent, received time.Time, elapsed time.Duration) (reply ntpReply, ok bool, err error) {
+	if len(msg) < ntpPacketSize || msg[0]&0x07 != ntpModeServer || binary.BigEndian.Uint64(msg[24:]) != origin {
+		return reply, false, nil
+	}
+	reply.leap = int(msg[0] >> 6)
+	reply.stratum = int(msg[1])
+	reply.refID = ntpRefID(msg[12:16], reply.stratum)
+	reply.rootDelay = ntpShort(binary.BigEndian.Uint32(msg[4:]))
+	reply.rootDispersion = ntpShort(binary.BigEndian.Uint32(msg[8:]))
+	if reply.stratum == 0 {
+		// Kiss-o'-Death 报文，参考标识为拒绝原因，如 RATE、DENY
+		return reply, true, fmt.Errorf(i18n.T().ErrorNTPKissCode(), reply.refID)
+	}
+	if reply.leap == ntpLeapAlarm {
+		return reply, true, errors.New(i18n.T().ErrorNTPUnsynchronized())
+	}
+	recvTS, xmitTS := binary.BigEndian.Uint64(msg[32:]), binary.BigEndian.Uint64(msg[40:])
+	if xmitTS == 0 {
+		return reply, true, errors.New(i18n.T().ErrorNTPReply())
+	}
+	t2, t3 := ntpToTime(recvTS), ntpToTime(xmitTS)
+	processing := t3.Sub(t2)
+	reply.delay = durationMillis(elapsed - processing)
+	reply.offset = durationMillis(t2.Sub(sent)+t3.Sub(received)) / 2
+	return reply, true, nil
+}
+
+// ntpExchange 发送一次客户端请求并等待起始时间戳相符的应答
+func ntpExchange(ctx context.Context, addr string) (ntpReply, float64, error) {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return ntpReply{}, 0, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	req := make([]byte, ntpPacketSize)
+	req[0] = ntpVersion<<3 | ntpModeClient
+	sent := time.Now()
+	origin := ntpTime(sent)
+	binary.BigEndian.PutUint64(req[40:], origin)
+	if _, err := conn.Write(req); err != nil {
+		return ntpReply{}, durationMillis(time.Since(sent)), err
+	}
+	buf := make([]byte, 512)
+	for {
+		n, err := conn.Read(buf)
+		elapsed := time.Since(sent)
+		if err != nil {
+			return ntpReply{}, durationMillis(elapsed), err
+		}
+		// 忽略之前超时的请求迟到的应答
+		reply, ok, err := parseNTPReply(buf[:n], origin, sent, sent.Add(elapsed), elapsed)
+		if ok {
+			return reply, durationMillis(elapsed), err
+		}
+	}
+}
+
+// ntpPingOnce 向 address:port 的 NTP 服务器发送一次 SNTP 请求，
+// 报告层级、参考标识、时钟偏移和往返延迟。0 级 (Kiss-o'-Death) 和未同步的服务器视为失败
+func ntpPingOnce(ctx context.Context, address, port string, stats *Statistics, seq int, ip string, opts *Options) probeResult {
+	lang := i18n.T()
+	result := probeResult{seq: seq, time: time.Now()}
+	endpoint := net.JoinHostPort(ip, port)
+
+	queryCtx, cancel := context.WithTimeout(ctx, probeTimeout(ctx, opts))
+	defer cancel()
+	// address 可能是带方括号的 IPv6 地址
+	reply, elapsed, err := ntpExchange(queryCtx, net.JoinHostPort(strings.Trim(address, "[]"), port))
+	if errors.Is(ctx.Err(), context.Canceled) {
+		fmt.Fprint(output, infoText(lang.MsgOperationCanceled(), opts.ColorOutput))
+		result.canceled = true
+		return result
+	}
+	if err != nil {
+		stats.update(elapsed, false)
+		fmt.Fprint(output, errorText(fmt.Sprintf(lang.MsgNTPFailed(), endpoint, seq, err), opts.ColorOutput))
+		result.rtt = elapsed
+		result.err = err.Error()
+		return result
+	}
+
+	stats.update(reply.delay, true)
+	stats.updateNTP(reply.offset)
+	result.rtt = reply.delay
+	result.success = true
+	fmt.Fprint(output, successText(fmt.Sprintf("NTP %s: seq=%d stratum=%d ref=%s offset=%+.3fms delay=%.2fms\n",
+		endpoint, seq, reply.stratum, reply.refID, reply.offset, reply.delay), opts.ColorOutput))
+	if opts.VerboseMode {
+		fmt.Fprintf(output, lang.MsgVerboseNTP(), reply.leap, reply.rootDelay, reply.rootDispersion)
+	}
+	return result
+}
+
+// updateNTP 记录一次成功查询的时钟偏移
+func (s *Statistics) updateNTP(offset float64) {
+	s.Lock()
+	defer s.Unlock()
+	s.ntpOffset.add(offset)
+}
+
+// getNTPOffset 返回时钟偏移的统计
+func (s *Statistics) getNTPOffset() latencyGroup {
+	s.RLock()
+	defer s.RUnlock()
+	return s.ntpOffset
+}
+
+// printNTPStatistics 输出往返延迟和时钟偏移的统计
+func printNTPStatistics(stats *Statistics) {
+	lang := i18n.T()
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Print(lang.MsgNTPStatisticsTitle())
+	if sent == 0 {
+		return
+	}
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Printf(lang.MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+	if offset := stats.getNTPOffset(); offset.count > 0 {
+		fmt.Printf(lang.MsgStatisticsNTPOffset(), offset.min, offset.max, offset.avg())
+	}
+}
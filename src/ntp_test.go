@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNTPTimeRoundTrip(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 250_000_000, time.UTC)
+	got := ntpToTime(ntpTime(now))
+	if d := got.Sub(now); d < -time.Microsecond || d > time.Microsecond {
+		t.Errorf("round trip = %v, want %v", got, now)
+	}
+	if secs := ntpTime(time.Unix(0, 0)) >> 32; secs != ntpEpochOffset {
+		t.Errorf("Unix epoch = %d NTP seconds, want %d", secs, uint64(ntpEpochOffset))
+	}
+}
+
+// ntpServerReply 构造应答，服务器时钟比请求中的本地时钟快 skew
+func ntpServerReply(req []byte, stratum byte, leap byte, refID string, skew time.Duration) []byte {
+	reply := make([]byte, ntpPacketSize)
+	reply[0] = leap<<6 | ntpVersion<<3 | ntpModeServer
+	reply[1] = stratum
+	binary.BigEndian.PutUint32(reply[4:], 0x00008000) // 根延迟 500ms
+	copy(reply[12:16], refID)
+	copy(reply[24:32], req[40:48])
+	now := time.Now().Add(skew)
+	binary.BigEndian.PutUint64(reply[32:], ntpTime(now))
+	binary.BigEndian.PutUint64(reply[40:], ntpTime(now))
+	return reply
+}
+
+// startNTPServer 启动 UDP NTP 服务端，先发送一个起始时间戳不符的应答再发送正确的应答
+func startNTPServer(t *testing.T, stratum, leap byte, refID string, skew time.Duration) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { pc.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil || n < ntpPacketSize {
+				return
+			}
+			stale := ntpServerReply(buf[:n], stratum, leap, refID, skew)
+			stale[24] ^= 0xff
+			pc.WriteTo(stale, addr)
+			pc.WriteTo(ntpServerReply(buf[:n], stratum, leap, refID, skew), addr)
+		}
+	}()
+	_, port, _ := net.SplitHostPort(pc.LocalAddr().String())
+	return port
+}
+
+// ntpProbe 对 127.0.0.1:port 执行一次 NTP 查询
+func ntpProbe(t *testing.T, port string, opts *Options) (probeResult, *Statistics, string) {
+	t.Helper()
+	opts.NTP = true
+	if opts.Timeout == 0 {
+		opts.Timeout = 2000
+	}
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	r := ntpPingOnce(context.Background(), "127.0.0.1", port, stats, 0, "127.0.0.1", opts)
+	return r, stats, buf.String()
+}
+
+func TestNTPPingOnce(t *testing.T) {
+	r, stats, out := ntpProbe(t, startNTPServer(t, 1, 0, "GPS", 250*time.Millisecond), &Options{VerboseMode: true})
+	if !r.success || !strings.Contains(out, "stratum=1 ref=GPS offset=+2") {
+		t.Fatalf("success = %v, err %q, output %q", r.success, r.err, out)
+	}
+	if !strings.Contains(out, "root delay = 500.00ms") {
+		t.Errorf("verbose output %q lacks the root delay", out)
+	}
+	offset := stats.getNTPOffset()
+	if offset.count != 1 || math.Abs(offset.avg()-250) > 20 {
+		t.Errorf("offset = %+v, want about +250ms", offset)
+	}
+
+	// 2 级以上的参考标识是上游服务器地址，本地时钟超前时偏移为负
+	r, _, out = ntpProbe(t, startNTPServer(t, 2, 0, "\xc0\x00\x02\x01", -time.Second), &Options{})
+	if !r.success || !strings.Contains(out, "stratum=2 ref=192.0.2.1 offset=-") {
+		t.Errorf("success = %v, output %q", r.success, out)
+	}
+}
+
+func TestNTPPingOnceFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		stratum, leap byte
+		refID, want   string
+	}{
+		{"kiss", 0, ntpLeapAlarm, "RATE", "RATE"},
+		{"unsynchronized", 2, ntpLeapAlarm, "\x0a\x00\x00\x01", "not synchronized"},
+	}
+	for _, tt := range tests {
+		r, stats, _ := ntpProbe(t, startNTPServer(t, tt.stratum, tt.leap, tt.refID, 0), &Options{})
+		if r.success || !strings.Contains(r.err, tt.want) {
+			t.Errorf("%s: success = %v, err %q; want %q", tt.name, r.success, r.err, tt.want)
+		}
+		if sent, responded, _, _, _ := stats.getStats(); sent != 1 || responded != 0 {
+			t.Errorf("%s: sent/responded = %d/%d", tt.name, sent, responded)
+		}
+	}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+	_, port, _ := net.SplitHostPort(pc.LocalAddr().String())
+	r, _, _ := ntpProbe(t, port, &Options{Timeout: 200})
+	if r.success || !strings.Contains(r.err, "timeout") {
+		t.Errorf("silent server: success = %v, err %q; want timeout", r.success, r.err)
+	}
+}
+
+func TestValidateNTPOptions(t *testing.T) {
+	if err := validateModes(&Options{NTP: true, DNS: true}); err == nil || !strings.Contains(err.Error(), "--ntp") {
+		t.Errorf("--dns with --ntp: %v", err)
+	}
+	if err := validateModes(&Options{NTP: true, CACert: "ca.pem"}); err == nil {
+		t.Error("--cacert with --ntp should be rejected")
+	}
+	if _, port, err := validateOptions(&Options{NTP: true}, []string{"pool.ntp.org"}); err != nil || port != "123" {
+		t.Errorf("default port = %q, %v; want 123", port, err)
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// persistentReadLimit 是等待 --expect 时缓存的最大字节数，超出后只保留末尾
+const persistentReadLimit = 64 << 10
+
+// unescapePayload 解析 --send 和 --expect 中的转义序列，如 \r\n、\x00、\t
+func unescapePayload(s string) (string, error) {
+	var b strings.Builder
+	for s != "" {
+		r, multibyte, tail, err := strconv.UnquoteChar(s, 0)
+		if err != nil {
+			return "", fmt.Errorf("invalid escape sequence in %q", s)
+		}
+		if r < 0x100 && !multibyte {
+			b.WriteByte(byte(r))
+		} else {
+			b.WriteRune(r)
+		}
+		s = tail
+	}
+	return b.String(), nil
+}
+
+// persistentProber 在一个长连接上反复发送请求并测量应答的往返时间。
+// 连接断开或应答超时后关闭连接，在下一次探测时重连
+type persistentProber struct {
+	address, port string
+	endpoint      string // 输出中显示的目标
+	opts          *Options
+	conn          net.Conn
+	connected     bool      // 是否曾经建立过连接，用于区分首次连接和重连
+	connectedAt   time.Time // 当前连接建立的时间
+	lastActive    time.Time // 当前连接上最后一次成功交换的时间
+}
+
+func newPersistentProber(address, port, endpoint string, opts *Options) *persistentProber {
+	return &persistentProber{address: address, port: port, endpoint: endpoint, opts: opts}
+}
+
+// dial 按目标类型直接连接、经代理连接或连接 Unix 域套接字
+func (p *persistentProber) dial(ctx context.Context) (net.Conn, proxyTiming, error) {
+	dialer := &net.Dialer{}
+	if path, ok := unixTargetPath(p.address); ok {
+		conn, err := dialer.DialContext(ctx, "unix", path)
+		return conn, proxyTiming{}, err
+	}
+	if p.opts.proxy != nil {
+		return p.opts.proxy.dial(ctx, dialer, p.address+":"+p.port)
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", p.address+":"+p.port)
+	return conn, proxyTiming{}, err
+}
+
+// request 返回本次探测发送的数据和期望的应答。
+// 未指定 --send 时发送带序号和时间戳的一行，期望对端原样返回；
+// 指定 --send 但未指定 --expect 时收到任意数据即视为应答
+func (p *persistentProber) request(seq int) (send, expect []byte) {
+	if p.opts.Send == "" {
+		line := []byte(fmt.Sprintf("tcping %d %d\n", seq, time.Now().UnixNano()))
+		return line, line
+	}
+	return []byte(p.opts.Send), []byte(p.opts.Expect)
+}
+
+// exchange 发送请求并读取到期望的应答为止，返回读取的字节数
+func (p *persistentProber) exchange(send, expect []byte) (int, error) {
+	if _, err := p.conn.Write(send); err != nil {
+		return 0, err
+	}
+	var got []byte
+	total := 0
+	buf := make([]byte, 4096)
+	for {
+		n, err := p.conn.Read(buf)
+		total += n
+		if n > 0 {
+			if len(expect) == 0 {
+				return total, nil
+			}
+			got = append(got, buf[:n]...)
+			if bytes.Contains(got, expect) {
+				return total, nil
+			}
+			if len(got) > persistentReadLimit {
+				got = got[len(got)-len(expect)+1:]
+			}
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// persistentTimeout 表示在超时内没有收到应答，与连接被重置或关闭相区分
+type persistentTimeout time.Duration
+
+func (t persistentTimeout) Error() string {
+	return fmt.Sprintf(i18n.T().ErrorPersistentTimeout(), time.Duration(t).Milliseconds())
+}
+
+// roundTrip 在当前连接上完成一次请求/应答，返回读取的字节数和耗时。
+// 超时或被中断时让阻塞的读写立即返回
+func (p *persistentProber) roundTrip(ctx context.Context, seq int, timeout time.Duration) (int, float64, error) {
+	conn := p.conn
+	conn.SetDeadline(time.Now().Add(timeout))
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+	send, expect := p.request(seq)
+	start := time.Now()
+	n, err := p.exchange(send, expect)
+	elapsed := durationMillis(time.Since(start))
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() && ctx.Err() == nil {
+		err = persistentTimeout(timeout)
+	}
+	return n, elapsed, err
+}
+
+// drop 关闭当前连接，记录连接存活的时间
+func (p *persistentProber) drop(stats *Statistics) (lifetime, idle time.Duration) {
+	p.conn.Close()
+	p.conn = nil
+	lifetime, idle = time.Since(p.connectedAt), time.Since(p.lastActive)
+	stats.updatePersistentDrop(durationMillis(lifetime))
+	return lifetime, idle
+}
+
+// probe 执行一次请求/应答测量，需要时先建立连接。
+// 探测耗时为请求的往返时间，建立连接的耗时单独统计
+func (p *persistentProber) probe(ctx context.Context, seq int, stats *Statistics) probeResult {
+	lang := i18n.T()
+	opts := p.opts
+	result := probeResult{seq: seq, time: time.Now()}
+	timeout := probeTimeout(ctx, opts)
+	canceled := func() probeResult {
+		fmt.Fprint(output, infoText(lang.MsgOperationCanceled(), opts.ColorOutput))
+		result.canceled = true
+		return result
+	}
+	fail := func(elapsed float64, msg string) probeResult {
+		stats.update(elapsed, false)
+		fmt.Fprint(output, errorText(msg, opts.ColorOutput))
+		result.rtt = elapsed
+		return result
+	}
+
+	var connect float64
+	newConn, reconnect := false, false
+	var timing proxyTiming
+	if p.conn == nil {
+		dialCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		conn, t, err := p.dial(dialCtx)
+		connect = durationMillis(time.Since(start))
+		cancel()
+		if errors.Is(ctx.Err(), context.Canceled) {
+			if conn != nil {
+				conn.Close()
+			}
+			return canceled()
+		}
+		if err != nil {
+			result.err = err.Error()
+			return fail(connect, fmt.Sprintf(lang.MsgPersistentConnectFailed(), p.endpoint, seq, err))
+		}
+		newConn, reconnect, timing = true, p.connected, t
+		p.conn, p.connected = conn, true
+		p.connectedAt, p.lastActive = time.Now(), time.Now()
+		stats.updatePersistentConnect(connect, reconnect)
+		if opts.proxy != nil {
+			stats.updateProxy(timing)
+		}
+	}
+
+	conn := p.conn
+	n, elapsed, err := p.roundTrip(ctx, seq, timeout)
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return canceled()
+	}
+	if err != nil {
+		// 连接已不可用或停止响应，下一次探测重新连接
+		lifetime, idle := p.drop(stats)
+		result.err = err.Error()
+		return fail(elapsed, fmt.Sprintf(lang.MsgPersistentDropped(), p.endpoint, seq, err,
+			lifetime.Round(time.Millisecond), idle.Round(time.Millisecond)))
+	}
+	p.lastActive = time.Now()
+
+	stats.update(elapsed, true)
+	result.rtt = elapsed
+	result.success = true
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "TCP %s: seq=%d bytes=%d time=%.2fms", p.endpoint, seq, n, elapsed)
+	if newConn {
+		fmt.Fprintf(&msg, " connect=%.2fms", connect)
+		if reconnect {
+			msg.WriteString(" (reconnect)")
+		}
+	}
+	msg.WriteByte('\n')
+	fmt.Fprint(output, successText(msg.String(), opts.ColorOutput))
+	if opts.VerboseMode && newConn {
+		fmt.Fprintf(output, lang.MsgVerboseConnection(), conn.LocalAddr().String(), p.address, p.port)
+		if opts.proxy != nil {
+			fmt.Fprintf(output, lang.MsgVerboseHTTPProxy(), opts.proxy,
+				durationMillis(timing.connect), durationMillis(timing.tunnel))
+		}
+	}
+	return result
+}
+
+// close 关闭当前连接
+func (p *persistentProber) close() {
+	if p.conn != nil {
+		p.conn.Close()
+		p.conn = nil
+	}
+}
+
+// updatePersistentConnect 记录一次建立长连接的耗时，reconnect 表示断线后重连
+func (s *Statistics) updatePersistentConnect(elapsed float64, reconnect bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.persistConnects.add(elapsed)
+	if reconnect {
+		s.persistReconnects++
+	}
+}
+
+// updatePersistentDrop 记录一次断开的长连接存活的时间（毫秒）
+func (s *Statistics) updatePersistentDrop(lifetime float64) {
+	s.Lock()
+	defer s.Unlock()
+	s.persistDrops.add(lifetime)
+}
+
+// getPersistentStats 返回建立连接的耗时、重连次数和断开连接的存活时间
+func (s *Statistics) getPersistentStats() (connects latencyGroup, reconnects int64, drops latencyGroup) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.persistConnects, s.persistReconnects, s.persistDrops
+}
+
+// printPersistentStatistics 输出请求往返时间、建立连接的耗时、重连次数和断线情况
+func printPersistentStatistics(stats *Statistics) {
+	lang := i18n.T()
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Print(lang.MsgPersistentStatisticsTitle())
+	if sent == 0 {
+		return
+	}
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Printf(lang.MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+	connects, reconnects, drops := stats.getPersistentStats()
+	if connects.count > 0 {
+		fmt.Printf(lang.MsgStatisticsPersistentConnect(), connects.count, connects.min, connects.max, connects.avg())
+	}
+	fmt.Printf(lang.MsgStatisticsPersistentDrops(), drops.count, reconnects)
+	if drops.count > 0 {
+		fmt.Printf(lang.MsgStatisticsPersistentLifetime(), drops.min/1000, drops.max/1000, drops.avg()/1000)
+	}
+	printProxyStatistics(stats)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestUnescapePayload(t *testing.T) {
+	got, err := unescapePayload(`PING\r\n\x00é`)
+	if err != nil || got != "PING\r\n\x00é" {
+		t.Errorf("unescapePayload = %q, %v", got, err)
+	}
+	if _, err := unescapePayload(`bad\q`); err == nil {
+		t.Error("unescapePayload should reject unknown escapes")
+	}
+}
+
+// startSessionServer 启动 TCP 服务端，每个连接交给 handle 处理，返回其端口
+func startSessionServer(t *testing.T, handle func(conn net.Conn)) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	return port
+}
+
+// persistentProbes 在同一个 persistentProber 上连续执行 n 次探测
+func persistentProbes(t *testing.T, port string, n int, opts *Options) ([]probeResult, *Statistics, string) {
+	t.Helper()
+	opts.Persistent = true
+	if opts.Timeout == 0 {
+		opts.Timeout = 2000
+	}
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	p := newPersistentProber("127.0.0.1", port, "127.0.0.1:"+port, opts)
+	defer p.close()
+	var results []probeResult
+	for seq := 0; seq < n; seq++ {
+		results = append(results, p.probe(context.Background(), seq, stats))
+	}
+	return results, stats, buf.String()
+}
+
+func TestPersistentEcho(t *testing.T) {
+	port := startSessionServer(t, func(conn net.Conn) { io.Copy(conn, conn) })
+	results, stats, out := persistentProbes(t, port, 3, &Options{})
+	for _, r := range results {
+		if !r.success {
+			t.Fatalf("seq %d failed: %s", r.seq, r.err)
+		}
+	}
+	if strings.Count(out, "connect=") != 1 || !strings.Contains(out, "seq=2 bytes=") {
+		t.Errorf("output %q should show a single connection", out)
+	}
+	if connects, reconnects, drops := stats.getPersistentStats(); connects.count != 1 || reconnects != 0 || drops.count != 0 {
+		t.Errorf("connects/reconnects/drops = %d/%d/%d, want 1/0/0", connects.count, reconnects, drops.count)
+	}
+}
+
+func TestPersistentSendExpect(t *testing.T) {
+	port := startSessionServer(t, func(conn net.Conn) {
+		br := bufio.NewReader(conn)
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil {
+				return
+			}
+			// 应答分两次写出，验证跨读取的匹配
+			if line == "PING\r\n" {
+				io.WriteString(conn, "+PO")
+				io.WriteString(conn, "NG\r\n")
+			} else {
+				io.WriteString(conn, "-ERR\r\n")
+			}
+		}
+	})
+	results, _, _ := persistentProbes(t, port, 2, &Options{Send: "PING\r\n", Expect: "+PONG"})
+	if !results[0].success || !results[1].success {
+		t.Errorf("results = %+v", results)
+	}
+
+	// 收到的应答不包含期望的数据时等待到超时
+	results, _, _ = persistentProbes(t, port, 1, &Options{Send: "INFO\r\n", Expect: "+PONG", Timeout: 200})
+	if results[0].success || !strings.Contains(results[0].err, "200ms") {
+		t.Errorf("mismatched reply: success = %v, err %q", results[0].success, results[0].err)
+	}
+}
+
+func TestPersistentDropAndReconnect(t *testing.T) {
+	// 每个连接只回答一次就关闭，模拟中间设备回收连接
+	port := startSessionServer(t, func(conn net.Conn) {
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err == nil {
+			io.WriteString(conn, line)
+		}
+	})
+	results, stats, out := persistentProbes(t, port, 3, &Options{})
+	if !results[0].success || results[1].success || !results[2].success {
+		t.Fatalf("results = %+v, output %q", results, out)
+	}
+	if !strings.Contains(out, "seq=1 connection dropped") || !strings.Contains(out, "(reconnect)") {
+		t.Errorf("output %q should report the drop and the reconnect", out)
+	}
+	if _, reconnects, drops := stats.getPersistentStats(); reconnects != 1 || drops.count != 1 {
+		t.Errorf("reconnects/drops = %d/%d, want 1/1", reconnects, drops.count)
+	}
+}
+
+func TestValidatePersistentOptions(t *testing.T) {
+	if err := validateModes(&Options{Send: "x"}); err == nil || !strings.Contains(err.Error(), "--persistent") {
+		t.Errorf("--send without --persistent: %v", err)
+	}
+	if err := validateModes(&Options{Persistent: true, Expect: "x"}); err == nil {
+		t.Error("--expect without --send should be rejected")
+	}
+	if err := validateModes(&Options{Persistent: true, Proto: "redis"}); err == nil {
+		t.Error("--proto with --persistent should be rejected")
+	}
+	if err := validateModes(&Options{Persistent: true, Send: "PING\r\n", Expect: "PONG", Proxy: "socks5://p"}); err != nil {
+		t.Errorf("--persistent through a proxy rejected: %v", err)
+	}
+}
@@ -0,0 +1,424 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// protoConn 是应用层握手使用的连接，done 标记应用层耗时的结束时间，
+// 之后读取版本等附加信息的时间不计入
+type protoConn struct {
+	net.Conn
+	br  *bufio.Reader
+	end time.Time
+}
+
+func (c *protoConn) done() {
+	if c.end.IsZero() {
+		c.end = time.Now()
+	}
+}
+
+// protoHandler 在已建立的连接上完成最小的协议握手，返回服务端版本等信息
+type protoHandler struct {
+	port  string // 未指定端口时使用的默认端口
+	probe func(c *protoConn) (string, error)
+}
+
+// protoHandlers 是 --proto 支持的应用层协议
+var protoHandlers = map[string]protoHandler{
+	"redis":     {"6379", probeRedis},
+	"mysql":     {"3306", probeMySQL},
+	"postgres":  {"5432", probePostgres},
+	"memcached": {"11211", probeMemcached},
+	"mongodb":   {"27017", probeMongoDB},
+	"smtp":      {"25", probeSMTP},
+	"ssh":       {"22", probeSSH},
+	"ftp":       {"21", probeFTP},
+}
+
+// parseProto 检查 --proto 的取值
+func parseProto(s string) (string, error) {
+	name := strings.ToLower(s)
+	if _, ok := protoHandlers[name]; !ok {
+		return "", fmt.Errorf("unknown protocol %q", s)
+	}
+	return name, nil
+}
+
+// protoResult 是一次应用层握手的耗时和服务端信息
+type protoResult struct {
+	elapsed float64
+	info    string
+}
+
+// runProto 在 conn 上执行 name 协议的握手，超时和上下文取消时关闭连接
+func runProto(ctx context.Context, conn net.Conn, name string, timeout int) (protoResult, error) {
+	conn.SetDeadline(time.Now().Add(time.Duration(timeout) * time.Millisecond))
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	c := &protoConn{Conn: conn, br: bufio.NewReader(conn)}
+	start := time.Now()
+	info, err := protoHandlers[name].probe(c)
+	c.done()
+	return protoResult{elapsed: durationMillis(c.end.Sub(start)), info: info}, err
+}
+
+// readLine 读取一行并去掉行尾的 CRLF
+func (c *protoConn) readLine() (string, error) {
+	line, err := c.br.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// redisMaxInfo 是 INFO server 应答的长度上限，长度由服务端给出，超过时不读取版本
+const redisMaxInfo = 64 << 10
+
+// probeRedis 发送 PING 并等待 PONG，随后通过 INFO 读取版本
+func probeRedis(c *protoConn) (string, error) {
+	if _, err := io.WriteString(c, "*1\r\n$4\r\nPING\r\n"); err != nil {
+		return "", err
+	}
+	line, err := c.readLine()
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case line == "+PONG":
+	case strings.HasPrefix(line, "-NOAUTH"):
+		// 服务端要求认证，但已经能处理命令
+		return "auth required", nil
+	case strings.HasPrefix(line, "-"):
+		return "", errors.New(strings.TrimPrefix(line, "-"))
+	default:
+		return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), line)
+	}
+	c.done()
+
+	if _, err := io.WriteString(c, "*2\r\n$4\r\nINFO\r\n$6\r\nserver\r\n"); err != nil {
+		return "", nil
+	}
+	head, err := c.readLine()
+	n, convErr := strconv.Atoi(strings.TrimPrefix(head, "$"))
+	if err != nil || !strings.HasPrefix(head, "$") || convErr != nil || n < 0 || n > redisMaxInfo {
+		return "", nil
+	}
+	body := make([]byte, n)
+	if _, err := io.ReadFull(c.br, body); err != nil {
+		return "", nil
+	}
+	for _, l := range strings.Split(string(body), "\r\n") {
+		if v, ok := strings.CutPrefix(l, "redis_version:"); ok {
+			return v, nil
+		}
+	}
+	return "", nil
+}
+
+// mysqlMaxPacket 是初始握手包的长度上限。握手包通常不到 100 字节，长度由服务端给出，
+// 超过时视为异常应答而不按其分配内存
+const mysqlMaxPacket = 4 << 10
+
+// probeMySQL 读取服务端的初始握手包，返回其中的版本号
+func probeMySQL(c *protoConn) (string, error) {
+	var head [4]byte
+	if _, err := io.ReadFull(c.br, head[:]); err != nil {
+		return "", err
+	}
+	length := int(head[0]) | int(head[1])<<8 | int(head[2])<<16
+	if length > mysqlMaxPacket {
+		return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), fmt.Sprintf("packet of %d bytes", length))
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		return "", err
+	}
+	if len(payload) == 0 {
+		return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), "empty packet")
+	}
+	switch payload[0] {
+	case 10: // 协议版本 10，随后是以 NUL 结尾的服务端版本
+		version, _, ok := strings.Cut(string(payload[1:]), "\x00")
+		if !ok {
+			return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), "truncated greeting")
+		}
+		return version, nil
+	case 0xff: // 错误包，如 Host is not allowed to connect
+		if len(payload) < 3 {
+			return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), "truncated error")
+		}
+		code := binary.LittleEndian.Uint16(payload[1:3])
+		msg := payload[3:]
+		if len(msg) >= 6 && msg[0] == '#' {
+			msg = msg[6:] // SQL 状态码
+		}
+		return "", fmt.Errorf("ERROR %d: %s", code, msg)
+	}
+	return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), fmt.Sprintf("protocol version %d", payload[0]))
+}
+
+// probePostgres 发送 SSLRequest，服务端以单个字节回答是否支持 SSL
+func probePostgres(c *protoConn) (string, error) {
+	if _, err := c.Write([]byte{0, 0, 0, 8, 0x04, 0xd2, 0x16, 0x2f}); err != nil {
+		return "", err
+	}
+	b, err := c.br.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	switch b {
+	case 'S':
+		return "SSL supported", nil
+	case 'N':
+		return "SSL not supported", nil
+	}
+	return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), fmt.Sprintf("%q", b))
+}
+
+// probeMemcached 发送 version 命令
+func probeMemcached(c *protoConn) (string, error) {
+	if _, err := io.WriteString(c, "version\r\n"); err != nil {
+		return "", err
+	}
+	line, err := c.readLine()
+	if err != nil {
+		return "", err
+	}
+	version, ok := strings.CutPrefix(line, "VERSION ")
+	if !ok {
+		return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), line)
+	}
+	return version, nil
+}
+
+// MongoDB OP_MSG 操作码
+const mongoOpMsg = 2013
+
+// mongoMaxReply 是 buildInfo 应答的长度上限，其中的 BSON 文档通常只有几 KB
+const mongoMaxReply = 16 << 10
+
+// probeMongoDB 用 OP_MSG 发送 buildInfo 命令（无需认证），返回服务端版本
+func probeMongoDB(c *protoConn) (string, error) {
+	lang := i18n.T()
+	var elems []byte
+	elems = append(elems, 0x10)
+	elems = append(elems, "buildInfo\x00"...)
+	elems = binary.LittleEndian.AppendUint32(elems, 1)
+	elems = append(elems, 0x02)
+	elems = append(elems, "$db\x00"...)
+	elems = binary.LittleEndian.AppendUint32(elems, 6)
+	elems = append(elems, "admin\x00"...)
+	doc := binary.LittleEndian.AppendUint32(nil, uint32(len(elems)+5))
+	doc = append(append(doc, elems...), 0)
+
+	// 消息头: 长度、requestID、responseTo、opCode，随后是 flagBits 和类型为 0 的文档
+	msg := binary.LittleEndian.AppendUint32(nil, uint32(16+4+1+len(doc)))
+	msg = binary.LittleEndian.AppendUint32(msg, 1)
+	msg = binary.LittleEndian.AppendUint32(msg, 0)
+	msg = binary.LittleEndian.AppendUint32(msg, mongoOpMsg)
+	msg = binary.LittleEndian.AppendUint32(msg, 0)
+	msg = append(append(msg, 0), doc...)
+	if _, err := c.Write(msg); err != nil {
+		return "", err
+	}
+
+	var head [16]byte
+	if _, err := io.ReadFull(c.br, head[:]); err != nil {
+		return "", err
+	}
+	length := binary.LittleEndian.Uint32(head[0:4])
+	if length < 16+4+1+5 || length > mongoMaxReply || binary.LittleEndian.Uint32(head[12:16]) != mongoOpMsg {
+		return "", fmt.Errorf(lang.ErrorProtoUnexpected(), "invalid OP_MSG reply")
+	}
+	body := make([]byte, length-16)
+	if _, err := io.ReadFull(c.br, body); err != nil {
+		return "", err
+	}
+	if body[4] != 0 {
+		return "", fmt.Errorf(lang.ErrorProtoUnexpected(), "invalid OP_MSG reply")
+	}
+	fields, err := bsonTopLevel(body[5:])
+	if err != nil {
+		return "", err
+	}
+	if ok, _ := fields["ok"].(float64); ok != 1 {
+		errmsg, _ := fields["errmsg"].(string)
+		if errmsg == "" {
+			errmsg = "buildInfo failed"
+		}
+		return "", errors.New(errmsg)
+	}
+	version, _ := fields["version"].(string)
+	return version, nil
+}
+
+// bsonTopLevel 解析 BSON 文档的顶层字段，只保留字符串和数值，其余类型跳过
+func bsonTopLevel(doc []byte) (map[string]any, error) {
+	invalid := fmt.Errorf(i18n.T().ErrorProtoUnexpected(), "invalid BSON document")
+	if len(doc) < 5 {
+		return nil, invalid
+	}
+	// 长度包括自身的 4 字节和结尾的 0，至少为 5
+	length := binary.LittleEndian.Uint32(doc)
+	if length < 5 || uint64(length) > uint64(len(doc)) {
+		return nil, invalid
+	}
+	doc = doc[4:length]
+	fields := make(map[string]any)
+	for len(doc) > 1 {
+		typ := doc[0]
+		end := bytes.IndexByte(doc[1:], 0)
+		if end < 0 {
+			return nil, invalid
+		}
+		name := string(doc[1 : 1+end])
+		doc = doc[2+end:]
+		size := 0
+		switch typ {
+		case 0x01: // double
+			size = 8
+			if len(doc) >= 8 {
+				fields[name] = math.Float64frombits(binary.LittleEndian.Uint64(doc))
+			}
+		case 0x02: // string
+			if len(doc) < 4 {
+				return nil, invalid
+			}
+			size = 4 + int(binary.LittleEndian.Uint32(doc))
+			if len(doc) >= size && size > 4 {
+				fields[name] = string(doc[4 : size-1])
+			}
+		case 0x03, 0x04: // 文档和数组
+			if len(doc) < 4 {
+				return nil, invalid
+			}
+			size = int(binary.LittleEndian.Uint32(doc))
+		case 0x05: // binary
+			if len(doc) < 4 {
+				return nil, invalid
+			}
+			size = 5 + int(binary.LittleEndian.Uint32(doc))
+		case 0x07: // ObjectId
+			size = 12
+		case 0x08: // bool
+			size = 1
+		case 0x0a: // null
+		case 0x10: // int32
+			size = 4
+			if len(doc) >= 4 {
+				fields[name] = float64(int32(binary.LittleEndian.Uint32(doc)))
+			}
+		case 0x09, 0x11, 0x12: // datetime、timestamp、int64
+			size = 8
+			if typ == 0x12 && len(doc) >= 8 {
+				fields[name] = float64(int64(binary.LittleEndian.Uint64(doc)))
+			}
+		case 0x13: // decimal128
+			size = 16
+		default:
+			return nil, invalid
+		}
+		if size < 0 || size > len(doc) {
+			return nil, invalid
+		}
+		doc = doc[size:]
+	}
+	return fields, nil
+}
+
+// readReply 读取 SMTP/FTP 风格的应答，多行应答以 "220-" 形式续行，
+// 返回状态码和第一行文本
+func (c *protoConn) readReply() (int, string, error) {
+	var code int
+	var text string
+	for i := 0; ; i++ {
+		line, err := c.readLine()
+		if err != nil {
+			return 0, "", err
+		}
+		if len(line) < 3 {
+			return 0, "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), line)
+		}
+		n, err := strconv.Atoi(line[:3])
+		if err != nil {
+			return 0, "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), line)
+		}
+		if i == 0 {
+			code, text = n, strings.TrimSpace(line[3:])
+			text = strings.TrimPrefix(text, "-")
+		}
+		if len(line) == 3 || line[3] != '-' {
+			return code, text, nil
+		}
+	}
+}
+
+// probeBanner 读取 220 欢迎应答后发送 QUIT
+func probeBanner(c *protoConn) (string, error) {
+	code, text, err := c.readReply()
+	if err != nil {
+		return "", err
+	}
+	if code != 220 {
+		return "", fmt.Errorf("%d %s", code, text)
+	}
+	c.done()
+	io.WriteString(c, "QUIT\r\n")
+	return text, nil
+}
+
+func probeSMTP(c *protoConn) (string, error) { return probeBanner(c) }
+
+func probeFTP(c *protoConn) (string, error) { return probeBanner(c) }
+
+// probeSSH 读取服务端的版本标识行，之前可能有其他文本行 (RFC 4253 第 4.2 节)
+func probeSSH(c *protoConn) (string, error) {
+	for range 10 {
+		line, err := c.readLine()
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(line, "SSH-") {
+			return line, nil
+		}
+	}
+	return "", fmt.Errorf(i18n.T().ErrorProtoUnexpected(), "no SSH identification")
+}
+
+// updateProto 记录一次成功的应用层握手耗时
+func (s *Statistics) updateProto(elapsed float64) {
+	s.Lock()
+	defer s.Unlock()
+	s.protoLatency.add(elapsed)
+}
+
+// getProtoStats 返回应用层握手耗时统计
+func (s *Statistics) getProtoStats() latencyGroup {
+	s.RLock()
+	defer s.RUnlock()
+	return s.protoLatency
+}
+
+// printProtoStatistics 在使用 --proto 时输出应用层握手耗时统计
+func printProtoStatistics(stats *Statistics) {
+	g := stats.getProtoStats()
+	if g.count == 0 {
+		return
+	}
+	fmt.Printf(i18n.T().MsgStatisticsProto(), g.count, g.min, g.max, g.avg())
+}
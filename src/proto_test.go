@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// startFakeServer 启动只处理一个连接的 TCP 服务端，返回其端口
+func startFakeServer(t *testing.T, handle func(conn net.Conn, br *bufio.Reader)) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handle(conn, bufio.NewReader(conn))
+	}()
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	return port
+}
+
+// protoProbe 使用 --proto name 对本地端口执行一次探测
+func protoProbe(t *testing.T, name, port string, timeout int) (probeResult, *Statistics, string) {
+	t.Helper()
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	stats := &Statistics{}
+	r := pingOnce(context.Background(), "127.0.0.1", port, timeout, stats, 0, "127.0.0.1", &Options{Proto: name})
+	return r, stats, buf.String()
+}
+
+// mongoReply 构造包含 version 和 ok 字段的 OP_MSG 应答
+func mongoReply(version string, ok float64) []byte {
+	var elems []byte
+	elems = append(elems, 0x03) // 嵌套文档会被跳过
+	elems = append(elems, "openssl\x00"...)
+	elems = append(elems, 5, 0, 0, 0, 0)
+	elems = append(elems, 0x02)
+	elems = append(elems, "version\x00"...)
+	elems = binary.LittleEndian.AppendUint32(elems, uint32(len(version)+1))
+	elems = append(append(elems, version...), 0)
+	elems = append(elems, 0x01)
+	elems = append(elems, "ok\x00"...)
+	elems = binary.LittleEndian.AppendUint64(elems, math.Float64bits(ok))
+	doc := binary.LittleEndian.AppendUint32(nil, uint32(len(elems)+5))
+	doc = append(append(doc, elems...), 0)
+
+	msg := binary.LittleEndian.AppendUint32(nil, uint32(16+4+1+len(doc)))
+	msg = binary.LittleEndian.AppendUint32(msg, 2)
+	msg = binary.LittleEndian.AppendUint32(msg, 1)
+	msg = binary.LittleEndian.AppendUint32(msg, mongoOpMsg)
+	msg = binary.LittleEndian.AppendUint32(msg, 0)
+	return append(append(msg, 0), doc...)
+}
+
+func TestPingOnceProto(t *testing.T) {
+	tests := []struct {
+		proto  string
+		handle func(conn net.Conn, br *bufio.Reader)
+		info   string
+	}{
+		{"redis", func(conn net.Conn, br *bufio.Reader) {
+			if cmd, _ := io.ReadFull(br, make([]byte, 14)); cmd != 14 {
+				return
+			}
+			io.WriteString(conn, "+PONG\r\n")
+			io.ReadFull(br, make([]byte, 26))
+			info := "# Server\r\nredis_version:7.2.4\r\n"
+			io.WriteString(conn, "$"+strconv.Itoa(len(info))+"\r\n"+info+"\r\n")
+		}, "7.2.4"},
+		{"mysql", func(conn net.Conn, br *bufio.Reader) {
+			payload := append([]byte{10}, "8.0.36\x00"...)
+			payload = append(payload, make([]byte, 20)...)
+			conn.Write(append([]byte{byte(len(payload)), 0, 0, 0}, payload...))
+		}, "8.0.36"},
+		{"postgres", func(conn net.Conn, br *bufio.Reader) {
+			req := make([]byte, 8)
+			io.ReadFull(br, req)
+			if binary.BigEndian.Uint32(req[4:]) == 80877103 {
+				conn.Write([]byte{'S'})
+			}
+		}, "SSL supported"},
+		{"memcached", func(conn net.Conn, br *bufio.Reader) {
+			if line, _ := br.ReadString('\n'); line == "version\r\n" {
+				io.WriteString(conn, "VERSION 1.6.21\r\n")
+			}
+		}, "1.6.21"},
+		{"mongodb", func(conn net.Conn, br *bufio.Reader) {
+			var head [16]byte
+			io.ReadFull(br, head[:])
+			io.ReadFull(br, make([]byte, binary.LittleEndian.Uint32(head[:])-16))
+			conn.Write(mongoReply("7.0.5", 1))
+		}, "7.0.5"},
+		{"smtp", func(conn net.Conn, br *bufio.Reader) {
+			io.WriteString(conn, "220-mail.test ESMTP Postfix\r\n220 ready\r\n")
+			br.ReadString('\n')
+		}, "mail.test ESMTP Postfix"},
+		{"ssh", func(conn net.Conn, br *bufio.Reader) {
+			io.WriteString(conn, "SSH-2.0-OpenSSH_9.6\r\n")
+		}, "SSH-2.0-OpenSSH_9.6"},
+		{"ftp", func(conn net.Conn, br *bufio.Reader) {
+			io.WriteString(conn, "220 (vsFTPd 3.0.5)\r\n")
+			br.ReadString('\n')
+		}, "(vsFTPd 3.0.5)"},
+	}
+	for _, tt := range tests {
+		port := startFakeServer(t, tt.handle)
+		r, stats, out := protoProbe(t, tt.proto, port, 2000)
+		if !r.success {
+			t.Errorf("%s: probe failed: %s", tt.proto, r.err)
+			continue
+		}
+		if !strings.Contains(out, " "+tt.proto+"=") || !strings.Contains(out, `server="`+tt.info+`"`) {
+			t.Errorf("%s: output %q lacks latency or server %q", tt.proto, out, tt.info)
+		}
+		if g := stats.getProtoStats(); g.count != 1 {
+			t.Errorf("%s: application handshakes = %d, want 1", tt.proto, g.count)
+		}
+	}
+}
+
+func TestPingOnceProtoFailures(t *testing.T) {
+	tests := []struct {
+		proto  string
+		handle func(conn net.Conn, br *bufio.Reader)
+		want   string
+	}{
+		{"redis", func(conn net.Conn, br *bufio.Reader) {
+			io.ReadFull(br, make([]byte, 14))
+			io.WriteString(conn, "-LOADING Redis is loading the dataset in memory\r\n")
+		}, "LOADING"},
+		{"mysql", func(conn net.Conn, br *bufio.Reader) {
+			payload := append([]byte{0xff, 0x6a, 0x04}, "Host '10.0.0.1' is not allowed to connect"...)
+			conn.Write(append([]byte{byte(len(payload)), 0, 0, 0}, payload...))
+		}, "ERROR 1130"},
+		{"smtp", func(conn net.Conn, br *bufio.Reader) {
+			io.WriteString(conn, "554 no SMTP service here\r\n")
+		}, "554"},
+		{"mongodb", func(conn net.Conn, br *bufio.Reader) {
+			var head [16]byte
+			io.ReadFull(br, head[:])
+			io.ReadFull(br, make([]byte, binary.LittleEndian.Uint32(head[:])-16))
+			conn.Write(mongoReply("", 0))
+		}, "buildInfo failed"},
+		// 接受连接但不发送任何数据，如挂起的数据库
+		{"ssh", func(conn net.Conn, br *bufio.Reader) {
+			br.ReadByte()
+		}, "timeout"},
+	}
+	for _, tt := range tests {
+		port := startFakeServer(t, tt.handle)
+		r, stats, out := protoProbe(t, tt.proto, port, 300)
+		if r.success || !strings.Contains(r.err, tt.want) {
+			t.Errorf("%s: success = %v, err %q; want error containing %q", tt.proto, r.success, r.err, tt.want)
+		}
+		if !strings.Contains(out, "handshake failed") {
+			t.Errorf("%s: output %q does not report the handshake failure", tt.proto, out)
+		}
+		if sent, responded, _, _, _ := stats.getStats(); sent != 1 || responded != 0 {
+			t.Errorf("%s: sent/responded = %d/%d, want 1/0", tt.proto, sent, responded)
+		}
+	}
+}
+
+func TestBSONTopLevelInvalidLength(t *testing.T) {
+	for length := uint32(0); length < 5; length++ {
+		doc := binary.LittleEndian.AppendUint32(nil, length)
+		doc = append(doc, 0x10, 'a', 0, 1, 0, 0, 0, 0)
+		if _, err := bsonTopLevel(doc); err == nil {
+			t.Errorf("length %d: expected an error", length)
+		}
+	}
+	doc := binary.LittleEndian.AppendUint32(nil, 100)
+	if _, err := bsonTopLevel(append(doc, 0)); err == nil {
+		t.Error("length beyond the document: expected an error")
+	}
+}
+
+func TestPingOnceRedisOversizedInfo(t *testing.T) {
+	// 服务端声明超大的 INFO 应答，不应按其长度分配内存
+	port := startFakeServer(t, func(conn net.Conn, br *bufio.Reader) {
+		io.ReadFull(br, make([]byte, 14))
+		io.WriteString(conn, "+PONG\r\n")
+		io.ReadFull(br, make([]byte, 26))
+		io.WriteString(conn, "$2000000000\r\n")
+	})
+	r, _, out := protoProbe(t, "redis", port, 2000)
+	if !r.success || strings.Contains(out, "server=") {
+		t.Errorf("success = %v, output %q; want success without server version", r.success, out)
+	}
+}
+
+func TestPingOnceProtoOversizedHandshake(t *testing.T) {
+	// 服务端声明的长度超过上限时直接判定失败，不按其长度分配内存
+	tests := []struct {
+		proto  string
+		handle func(conn net.Conn, br *bufio.Reader)
+	}{
+		{"mysql", func(conn net.Conn, br *bufio.Reader) {
+			conn.Write([]byte{0xff, 0xff, 0xff, 0, 10})
+			br.ReadByte()
+		}},
+		{"mongodb", func(conn net.Conn, br *bufio.Reader) {
+			var head [16]byte
+			io.ReadFull(br, head[:])
+			io.ReadFull(br, make([]byte, binary.LittleEndian.Uint32(head[:])-16))
+			reply := mongoReply("7.0.0", 1)
+			binary.LittleEndian.PutUint32(reply, mongoMaxReply+1)
+			conn.Write(reply)
+			br.ReadByte()
+		}},
+	}
+	for _, tt := range tests {
+		port := startFakeServer(t, tt.handle)
+		r, _, _ := protoProbe(t, tt.proto, port, 2000)
+		if r.success || strings.Contains(r.err, "timeout") {
+			t.Errorf("%s: success = %v, err %q; want an immediate handshake failure", tt.proto, r.success, r.err)
+		}
+	}
+}
+
+func TestParseProto(t *testing.T) {
+	if name, err := parseProto("Redis"); err != nil || name != "redis" {
+		t.Errorf("parseProto(Redis) = %q, %v", name, err)
+	}
+	if _, err := parseProto("oracle"); err == nil {
+		t.Error("parseProto should reject unknown protocols")
+	}
+}
+
+func TestValidateOptionsProtoDefaultPort(t *testing.T) {
+	_, port, err := validateOptions(&Options{Proto: "postgres"}, []string{"db.internal"})
+	if err != nil || port != "5432" {
+		t.Errorf("port = %q, %v; want 5432", port, err)
+	}
+	if err := validateModes(&Options{Proto: "redis", HTTPMode: true}); err == nil {
+		t.Error("--proto with -H should be rejected")
+	}
+}
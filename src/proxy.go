@@ -0,0 +1,348 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// 代理地址未指定端口时使用的默认端口，与 curl 一致
+const defaultProxyPort = "1080"
+
+// proxyDialer 通过 HTTP CONNECT 或 SOCKS5 代理建立到目标的 TCP 连接。
+// http:// 和 socks5h:// 由代理解析目标主机名，socks5:// 在本地解析
+type proxyDialer struct {
+	url *url.URL
+}
+
+// proxyTiming 把一次连接的耗时拆分为连接代理和建立到目标的隧道两部分
+type proxyTiming struct {
+	connect time.Duration // 与代理建立 TCP 连接
+	tunnel  time.Duration // CONNECT 或 SOCKS5 握手直到代理连上目标
+}
+
+func newProxyDialer(raw string) (*proxyDialer, error) {
+	lang := i18n.T()
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf(lang.ErrorProxyURL(), raw)
+	}
+	switch u.Scheme {
+	case "http", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf(lang.ErrorProxyScheme(), u.Scheme)
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), defaultProxyPort)
+	}
+	return &proxyDialer{url: u}, nil
+}
+
+// loadProxy 根据 --proxy 或（指定 --proxy-env 时）HTTP_PROXY/HTTPS_PROXY/NO_PROXY
+// 环境变量为 target 选择代理，保存到 opts.proxy。--proxy 优先于环境变量
+func loadProxy(opts *Options, target *url.URL) error {
+	raw := opts.Proxy
+	if raw == "" && opts.ProxyEnv {
+		u, err := http.ProxyFromEnvironment(&http.Request{URL: target})
+		if err != nil {
+			return err
+		}
+		if u == nil {
+			return nil
+		}
+		raw = u.String()
+	}
+	if raw == "" {
+		return nil
+	}
+	p, err := newProxyDialer(raw)
+	if err != nil {
+		return err
+	}
+	opts.proxy = p
+	return nil
+}
+
+// remoteDNS 报告目标主机名是否交给代理解析
+func (p *proxyDialer) remoteDNS() bool {
+	return p.url.Scheme != "socks5"
+}
+
+// String 返回隐藏密码后的代理地址，用于输出
+func (p *proxyDialer) String() string {
+	return p.url.Redacted()
+}
+
+// dial 使用 dialer 连接代理并建立到 addr 的隧道
+func (p *proxyDialer) dial(ctx context.Context, dialer *net.Dialer, addr string) (net.Conn, proxyTiming, error) {
+	var timing proxyTiming
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, timing, err
+	}
+	if !p.remoteDNS() && net.ParseIP(host) == nil {
+		ips, err := net.DefaultResolver.LookupIP(ctx, "ip", host)
+		if err != nil {
+			return nil, timing, err
+		}
+		host = ips[0].String()
+	}
+
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", p.url.Host)
+	timing.connect = time.Since(start)
+	if err != nil {
+		return nil, timing, fmt.Errorf(i18n.T().ErrorProxyDial(), p, err)
+	}
+
+	// 握手期间遵守上下文的截止时间和取消
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+
+	tunnelStart := time.Now()
+	if p.url.Scheme == "http" {
+		conn, err = p.connectHTTP(conn, net.JoinHostPort(host, port))
+	} else {
+		err = p.connectSOCKS5(conn, host, port)
+	}
+	timing.tunnel = time.Since(tunnelStart)
+
+	if !stop() && err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, timing, err
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, timing, nil
+}
+
+// connectHTTP 发送 CONNECT 请求建立隧道
+func (p *proxyDialer) connectHTTP(conn net.Conn, addr string) (net.Conn, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if u := p.url.User; u != nil {
+		password, _ := u.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(u.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	if err := req.Write(conn); err != nil {
+		return conn, err
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return conn, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return conn, fmt.Errorf(i18n.T().ErrorProxyConnect(), resp.Status)
+	}
+	if br.Buffered() > 0 {
+		// 代理在响应之后已经转发了目标的数据
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn 先返回读缓冲中剩余的数据
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// SOCKS5 协议常量 (RFC 1928, RFC 1929)
+const (
+	socksVersion      = 5
+	socksAuthNone     = 0x00
+	socksAuthPassword = 0x02
+	socksCmdConnect   = 0x01
+	socksAtypIPv4     = 0x01
+	socksAtypDomain   = 0x03
+	socksAtypIPv6     = 0x04
+)
+
+// socksReplies 是 SOCKS5 应答码的含义
+var socksReplies = map[byte]string{
+	0x01: "general SOCKS server failure",
+	0x02: "connection not allowed by ruleset",
+	0x03: "network unreachable",
+	0x04: "host unreachable",
+	0x05: "connection refused",
+	0x06: "TTL expired",
+	0x07: "command not supported",
+	0x08: "address type not supported",
+}
+
+// connectSOCKS5 完成 SOCKS5 认证并请求代理连接目标
+func (p *proxyDialer) connectSOCKS5(conn net.Conn, host, port string) error {
+	lang := i18n.T()
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return err
+	}
+
+	methods := []byte{socksAuthNone}
+	if p.url.User != nil {
+		methods = append(methods, socksAuthPassword)
+	}
+	greeting := append([]byte{socksVersion, byte(len(methods))}, methods...)
+	if _, err := conn.Write(greeting); err != nil {
+		return err
+	}
+	var choice [2]byte
+	if _, err := io.ReadFull(conn, choice[:]); err != nil {
+		return err
+	}
+	if choice[0] != socksVersion {
+		return errors.New(lang.ErrorSOCKSProtocol())
+	}
+	switch choice[1] {
+	case socksAuthNone:
+	case socksAuthPassword:
+		if p.url.User == nil {
+			return errors.New(lang.ErrorSOCKSNoMethod())
+		}
+		if err := p.socksAuth(conn); err != nil {
+			return err
+		}
+	default:
+		return errors.New(lang.ErrorSOCKSNoMethod())
+	}
+
+	req := []byte{socksVersion, socksCmdConnect, 0}
+	if ip := net.ParseIP(host); ip == nil {
+		if len(host) > 255 {
+			return fmt.Errorf(lang.ErrorProxyURL(), host)
+		}
+		req = append(req, socksAtypDomain, byte(len(host)))
+		req = append(req, host...)
+	} else if ip4 := ip.To4(); ip4 != nil {
+		req = append(req, socksAtypIPv4)
+		req = append(req, ip4...)
+	} else {
+		req = append(req, socksAtypIPv6)
+		req = append(req, ip.To16()...)
+	}
+	req = binary.BigEndian.AppendUint16(req, uint16(portNum))
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	// 应答: VER REP RSV ATYP BND.ADDR BND.PORT
+	var head [4]byte
+	if _, err := io.ReadFull(conn, head[:]); err != nil {
+		return err
+	}
+	if head[0] != socksVersion {
+		return errors.New(lang.ErrorSOCKSProtocol())
+	}
+	if head[1] != 0 {
+		msg, ok := socksReplies[head[1]]
+		if !ok {
+			msg = "unknown error " + strconv.Itoa(int(head[1]))
+		}
+		return fmt.Errorf(lang.ErrorSOCKSReply(), msg)
+	}
+	var addrLen int
+	switch head[3] {
+	case socksAtypIPv4:
+		addrLen = net.IPv4len
+	case socksAtypIPv6:
+		addrLen = net.IPv6len
+	case socksAtypDomain:
+		var l [1]byte
+		if _, err := io.ReadFull(conn, l[:]); err != nil {
+			return err
+		}
+		addrLen = int(l[0])
+	default:
+		return errors.New(lang.ErrorSOCKSProtocol())
+	}
+	_, err = io.ReadFull(conn, make([]byte, addrLen+2))
+	return err
+}
+
+// socksAuth 进行用户名/密码认证 (RFC 1929)
+func (p *proxyDialer) socksAuth(conn net.Conn) error {
+	user := p.url.User.Username()
+	password, _ := p.url.User.Password()
+	if len(user) > 255 || len(password) > 255 {
+		return errors.New(i18n.T().ErrorSOCKSAuth())
+	}
+	req := []byte{1, byte(len(user))}
+	req = append(req, user...)
+	req = append(req, byte(len(password)))
+	req = append(req, password...)
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+	var resp [2]byte
+	if _, err := io.ReadFull(conn, resp[:]); err != nil {
+		return err
+	}
+	if resp[1] != 0 {
+		return errors.New(i18n.T().ErrorSOCKSAuth())
+	}
+	return nil
+}
+
+// proxyTraceKey 用于在请求上下文中传递 connTrace，
+// 使 HTTP 传输层拨号时可以记录代理各阶段的耗时
+type proxyTraceKey struct{}
+
+func withProxyTrace(ctx context.Context, trace *connTrace) context.Context {
+	return context.WithValue(ctx, proxyTraceKey{}, trace)
+}
+
+func proxyTraceFrom(ctx context.Context) *connTrace {
+	trace, _ := ctx.Value(proxyTraceKey{}).(*connTrace)
+	return trace
+}
+
+// updateProxy 记录经代理建立的连接的代理连接耗时和隧道耗时
+func (s *Statistics) updateProxy(t proxyTiming) {
+	s.Lock()
+	defer s.Unlock()
+	s.proxyConnect.add(durationMillis(t.connect))
+	s.proxyTunnel.add(durationMillis(t.tunnel))
+}
+
+// getProxyStats 返回代理连接和隧道建立的耗时统计
+func (s *Statistics) getProxyStats() (connect, tunnel latencyGroup) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.proxyConnect, s.proxyTunnel
+}
+
+// printProxyStatistics 在经过代理时输出代理连接和隧道建立的耗时统计
+func printProxyStatistics(stats *Statistics) {
+	connect, tunnel := stats.getProxyStats()
+	if connect.count == 0 {
+		return
+	}
+	fmt.Printf(i18n.T().MsgStatisticsProxyConnect(), connect.count, connect.min, connect.max, connect.avg())
+	fmt.Printf(i18n.T().MsgStatisticsProxyTunnel(), tunnel.count, tunnel.min, tunnel.max, tunnel.avg())
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// recordEntry 是 --record 文件中的一行，对应一次探测结果。
+// run 是本次运行开始的时间，同一文件中追加的多次运行据此区分
+type recordEntry struct {
+	Time       time.Time `json:"time"`
+	Run        string    `json:"run"`
+	Target     string    `json:"target"`
+	Mode       string    `json:"mode"`
+	Seq        int       `json:"seq"`
+	Success    bool      `json:"success"`
+	RTT        float64   `json:"rtt_ms"`
+	Error      string    `json:"error,omitempty"`
+	TimeoutMs  float64   `json:"timeout_ms,omitempty"`
+	IntervalMs float64   `json:"interval_ms,omitempty"`
+}
+
+// recorder 把每次探测结果以 JSON Lines 格式追加到文件，每条结果单独写入，
+// 程序被中断时已经完成的探测不会丢失
+type recorder struct {
+	sync.Mutex
+	path   string
+	file   *os.File
+	enc    *json.Encoder
+	run    string
+	target string
+	mode   string
+	err    error // 第一次写入失败的原因，之后不再写入
+}
+
+func newRecorder(path, target, mode string) (*recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T().ErrorRecordOpen(), path, err)
+	}
+	return &recorder{
+		path:   path,
+		file:   f,
+		enc:    json.NewEncoder(f),
+		run:    time.Now().Format(time.RFC3339Nano),
+		target: target,
+		mode:   mode,
+	}, nil
+}
+
+// record 写入一次探测结果，被取消的探测不写入
+func (rec *recorder) record(r probeResult) {
+	if r.canceled {
+		return
+	}
+	t := r.time
+	if t.IsZero() {
+		t = time.Now()
+	}
+	rec.Lock()
+	defer rec.Unlock()
+	if rec.err != nil {
+		return
+	}
+	rec.err = rec.enc.Encode(recordEntry{
+		Time:       t,
+		Run:        rec.run,
+		Target:     rec.target,
+		Mode:       rec.mode,
+		Seq:        r.seq,
+		Success:    r.success,
+		RTT:        r.rtt,
+		Error:      r.err,
+		TimeoutMs:  durationMillis(r.timeout),
+		IntervalMs: durationMillis(r.interval),
+	})
+}
+
+// close 关闭文件，返回写入或关闭时的错误
+func (rec *recorder) close() error {
+	rec.Lock()
+	defer rec.Unlock()
+	err := rec.file.Close()
+	if rec.err != nil {
+		err = rec.err
+	}
+	if err != nil {
+		return fmt.Errorf(i18n.T().ErrorRecordWrite(), rec.path, err)
+	}
+	return nil
+}
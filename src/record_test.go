@@ -0,0 +1,49 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecorderAppendsRuns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.jsonl")
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for run := 0; run < 2; run++ {
+		rec, err := newRecorder(path, "example.com:443", "tcp")
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec.record(probeResult{seq: 0, time: start, success: true, rtt: 12.5, timeout: time.Second, interval: time.Second})
+		rec.record(probeResult{seq: 1, time: start.Add(time.Second), err: "i/o timeout"})
+		rec.record(probeResult{seq: 2, canceled: true})
+		if err := rec.close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := readRecords(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("records = %d, want 4 (canceled probes are not recorded)", len(records))
+	}
+	first := records[0]
+	if first.Target != "example.com:443" || first.Mode != "tcp" || !first.Success || first.RTT != 12.5 ||
+		!first.Time.Equal(start) || first.TimeoutMs != 1000 || first.IntervalMs != 1000 {
+		t.Errorf("first record = %+v", first)
+	}
+	if records[1].Success || records[1].Error != "i/o timeout" {
+		t.Errorf("failed record = %+v", records[1])
+	}
+	if records[0].Run == "" || records[0].Run != records[1].Run {
+		t.Errorf("records of one run should share the run id: %q, %q", records[0].Run, records[1].Run)
+	}
+}
+
+func TestRecorderOpenError(t *testing.T) {
+	if _, err := newRecorder(filepath.Join(t.TempDir(), "missing", "run.jsonl"), "x", "tcp"); err == nil {
+		t.Error("opening a record file in a missing directory should fail")
+	}
+}
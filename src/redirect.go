@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// 默认最多跟随的重定向次数
+const defaultMaxRedirs = 10
+
+// redirectHop 记录重定向链中的一跳
+type redirectHop struct {
+	status   int
+	url      string  // 本跳请求的地址
+	location string  // 重定向目标
+	elapsed  float64 // 本跳收到响应头的耗时（毫秒）
+}
+
+func isRedirectStatus(code int) bool {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	}
+	return false
+}
+
+// followRedirects 执行请求并手动跟随重定向，以便记录每一跳的耗时。
+// 重定向循环、https 降级到 http 以及超过 maxRedirs 次都视为失败，
+// 此时返回已经经过的跳转和错误
+func followRedirects(client *http.Client, req *http.Request, maxRedirs int) (*http.Response, []redirectHop, error) {
+	lang := i18n.T()
+	var hops []redirectHop
+	visited := map[string]bool{req.URL.String(): true}
+	for {
+		start := time.Now()
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, hops, err
+		}
+		if !isRedirectStatus(resp.StatusCode) {
+			return resp, hops, nil
+		}
+		loc, err := resp.Location()
+		if err != nil {
+			// 没有 Location 的 3xx 响应直接作为最终结果
+			return resp, hops, nil
+		}
+		// 读取并丢弃少量响应体，使连接可以继续复用
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+
+		hops = append(hops, redirectHop{
+			status:   resp.StatusCode,
+			url:      req.URL.String(),
+			location: loc.String(),
+			elapsed:  durationMillis(time.Since(start)),
+		})
+
+		switch {
+		case req.URL.Scheme == "https" && loc.Scheme == "http":
+			return nil, hops, fmt.Errorf(lang.ErrorRedirectDowngrade(), req.URL, loc)
+		case visited[loc.String()]:
+			return nil, hops, fmt.Errorf(lang.ErrorRedirectLoop(), loc)
+		case len(hops) > maxRedirs:
+			return nil, hops, fmt.Errorf(lang.ErrorTooManyRedirects(), maxRedirs)
+		}
+		visited[loc.String()] = true
+
+		next, err := http.NewRequestWithContext(req.Context(), http.MethodGet, loc.String(), nil)
+		if err != nil {
+			return nil, hops, err
+		}
+		next.Header = req.Header.Clone()
+		req = next
+	}
+}
+
+// printRedirectHops 在详细模式下输出每一跳的状态码、目标地址和耗时
+func printRedirectHops(hops []redirectHop) {
+	for i, hop := range hops {
+		fmt.Fprintf(output, i18n.T().MsgVerboseHTTPRedirect(), i+1, hop.status, hop.url, hop.location, hop.elapsed)
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newRedirectServer 返回按路径重定向的测试服务器：/a -> /b -> /final，
+// /loop1 <-> /loop2，/n/<k> 连续重定向 k 次
+func newRedirectServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/a", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/b", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/b", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/final", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/final", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "done")
+	})
+	mux.HandleFunc("/loop1", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop2", http.StatusFound)
+	})
+	mux.HandleFunc("/loop2", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop1", http.StatusFound)
+	})
+	mux.HandleFunc("/n/", func(w http.ResponseWriter, r *http.Request) {
+		k := strings.Count(r.URL.Path, "x")
+		if k == 0 {
+			io.WriteString(w, "done")
+			return
+		}
+		http.Redirect(w, r, "/n/"+strings.Repeat("x", k-1), http.StatusFound)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestFollowRedirectsChain(t *testing.T) {
+	srv := newRedirectServer()
+	defer srv.Close()
+
+	req, _ := http.NewRequest("GET", srv.URL+"/a", nil)
+	resp, hops, err := followRedirects(newHTTPProbeClient(&Options{}).client, req, defaultMaxRedirs)
+	if err != nil {
+		t.Fatalf("followRedirects: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("final status = %d, want 200", resp.StatusCode)
+	}
+	if len(hops) != 2 {
+		t.Fatalf("hops = %d, want 2", len(hops))
+	}
+	if hops[0].status != http.StatusMovedPermanently || hops[0].location != srv.URL+"/b" {
+		t.Errorf("hop 1 = %+v, want 301 -> /b", hops[0])
+	}
+	if hops[1].status != http.StatusTemporaryRedirect || hops[1].location != srv.URL+"/final" {
+		t.Errorf("hop 2 = %+v, want 307 -> /final", hops[1])
+	}
+}
+
+func TestFollowRedirectsFailures(t *testing.T) {
+	srv := newRedirectServer()
+	defer srv.Close()
+	tlsSrv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, srv.URL+"/final", http.StatusFound)
+	}))
+	defer tlsSrv.Close()
+
+	tests := []struct {
+		name      string
+		uri       string
+		maxRedirs int
+		wantHops  int
+	}{
+		{"loop", srv.URL + "/loop1", defaultMaxRedirs, 2},
+		{"downgrade", tlsSrv.URL, defaultMaxRedirs, 1},
+		{"too many", srv.URL + "/n/xxx", 2, 3},
+		{"redirects disabled", srv.URL + "/a", 0, 1},
+	}
+	client := newHTTPProbeClient(&Options{InsecureSSL: true}).client
+	for _, tt := range tests {
+		req, _ := http.NewRequest("GET", tt.uri, nil)
+		resp, hops, err := followRedirects(client, req, tt.maxRedirs)
+		if err == nil {
+			resp.Body.Close()
+			t.Errorf("%s: expected an error", tt.name)
+			continue
+		}
+		if len(hops) != tt.wantHops {
+			t.Errorf("%s: hops = %d, want %d", tt.name, len(hops), tt.wantHops)
+		}
+	}
+}
+
+func TestHTTPPingOnceFollow(t *testing.T) {
+	srv := newRedirectServer()
+	defer srv.Close()
+
+	opts := &Options{Follow: true, MaxRedirs: defaultMaxRedirs, VerboseMode: true}
+	out := probeOutput(t, srv.URL+"/a", opts)
+	if !strings.Contains(out, "HTTP 200 ") || !strings.Contains(out, " redirects=2 ") {
+		t.Errorf("output %q does not report the final response after 2 redirects", out)
+	}
+
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	opts = &Options{Follow: true, MaxRedirs: defaultMaxRedirs}
+	r := httpPingOnce(context.Background(), newHTTPProbeClient(opts), srv.URL+"/loop1", 5000, &Statistics{}, 0, opts)
+	if r.success {
+		t.Error("redirect loop should be reported as a failure")
+	}
+}
+
+func TestHTTPPingOnceFollowTimeoutCoversChain(t *testing.T) {
+	// 每一跳耗时 150ms，单独计算时都不超过 -w，合计超过 -w
+	srv := newRedirectServer()
+	defer srv.Close()
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(150 * time.Millisecond)
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer slow.Close()
+
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	opts := &Options{Follow: true, MaxRedirs: defaultMaxRedirs}
+	start := time.Now()
+	r := httpPingOnce(context.Background(), newHTTPProbeClient(opts), slow.URL+"/n/xxxx", 400, &Statistics{}, 0, opts)
+	if r.success {
+		t.Error("a redirect chain longer than -w should time out")
+	}
+	if elapsed := time.Since(start); elapsed > 550*time.Millisecond {
+		t.Errorf("probe took %v, -w should bound the whole chain", elapsed)
+	}
+}
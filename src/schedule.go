@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// lateTolerance 是探测晚于计划时间但不计为迟发的误差
+const lateTolerance = 10 * time.Millisecond
+
+// probeSchedule 计算每个探测时间点。时间点固定为 anchor + (slot-anchorSlot)×interval，
+// 不随探测耗时顺延；--interval-jitter 为每个时间点加上 [0, jitter) 的随机偏移
+type probeSchedule struct {
+	anchor     time.Time // 第 anchorSlot 个时间点，改变间隔时移动
+	anchorSlot int
+	interval   time.Duration
+	jitter     time.Duration
+	seed       uint64
+}
+
+func newProbeSchedule(opts *Options, start time.Time) *probeSchedule {
+	return &probeSchedule{
+		anchor:   start,
+		interval: time.Duration(opts.Interval) * time.Millisecond,
+		jitter:   opts.IntervalJitter,
+		seed:     rand.Uint64(),
+	}
+}
+
+// nominal 返回第 slot 个时间点，不含随机偏移
+func (s *probeSchedule) nominal(slot int) time.Time {
+	return s.anchor.Add(time.Duration(slot-s.anchorSlot) * s.interval)
+}
+
+// due 返回第 slot 个时间点，第一次探测立即发出
+func (s *probeSchedule) due(slot int) time.Time {
+	t := s.nominal(slot)
+	if slot > 0 && s.jitter > 0 {
+		// 按时间点确定偏移，同一时间点多次计算结果相同
+		r := rand.New(rand.NewPCG(s.seed, uint64(slot)))
+		t = t.Add(time.Duration(r.Int64N(int64(s.jitter))))
+	}
+	return t
+}
+
+// retime 从第 slot 个时间点起改用新的间隔，以上一个时间点为基准；
+// 基准加上间隔已经过去时，第 slot 个时间点为 now
+func (s *probeSchedule) retime(slot int, interval time.Duration, now time.Time) {
+	next := s.nominal(slot - 1).Add(interval)
+	if next.Before(now) {
+		next = now
+	}
+	s.anchor, s.anchorSlot, s.interval = next, slot, interval
+}
+
+// missed 返回 slot 之后已经到期的时间点数，这些时间点被跳过，只发出最近的一次。
+// 间隔为 0 时没有固定的时间点，不会跳过
+func (s *probeSchedule) missed(slot int, now time.Time) int {
+	if s.interval <= 0 {
+		return 0
+	}
+	n := 0
+	for !s.due(slot + n + 1).After(now) {
+		n++
+	}
+	return n
+}
+
+// late 返回在 now 发出第 slot 个时间点的探测比计划晚了多久，误差以内视为准时
+func (s *probeSchedule) late(slot int, now time.Time) time.Duration {
+	if s.interval <= 0 {
+		return 0
+	}
+	if d := now.Sub(s.due(slot)); d > lateTolerance {
+		return d
+	}
+	return 0
+}
+
+// sequentialProbe 判断探测是否只能逐次进行：依赖共享的连接或套接字，
+// 或者 --adaptive 需要根据上一次的结果决定下一次探测
+func sequentialProbe(opts *Options) bool {
+	return opts.Persistent || opts.WebSocket || opts.ICMP || opts.CompareICMP || opts.Adaptive ||
+		sequentialHTTP(opts)
+}
+
+// sequentialHTTP 判断 HTTP 探测是否只能逐次进行。HTTP/1.1 的连接同一时间只处理一个请求，
+// 线路字节数按连接计算；HTTP/2 和 HTTP/3 的多个请求共用一个连接，无法区分各自的字节数，
+// 只能按客户端计数器的差值计算。吞吐量测试本身同时使用多个连接
+func sequentialHTTP(opts *Options) bool {
+	return opts.HTTP2 || opts.HTTP3 || opts.Throughput
+}
+
+// maxInFlight 返回同时进行的探测上限。未指定 --max-inflight 时按超时和间隔估算，
+// 使慢速探测不推迟后续的时间点
+func maxInFlight(opts *Options) int {
+	if sequentialProbe(opts) {
+		return 1
+	}
+	if opts.MaxInFlight > 0 {
+		return opts.MaxInFlight
+	}
+	if opts.Interval <= 0 || opts.Timeout <= opts.Interval {
+		return 1
+	}
+	return (opts.Timeout + opts.Interval - 1) / opts.Interval
+}
+
+// updateSchedule 记录晚于计划时间发出的探测数和被跳过的时间点数
+func (s *Statistics) updateSchedule(late, skipped int64) {
+	s.Lock()
+	defer s.Unlock()
+	s.scheduleLate += late
+	s.scheduleSkipped += skipped
+}
+
+// getScheduleStats 返回迟发的探测数和被跳过的时间点数
+func (s *Statistics) getScheduleStats() (late, skipped int64) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.scheduleLate, s.scheduleSkipped
+}
+
+// printScheduleStatistics 在有探测迟发或时间点被跳过时输出一行说明
+func printScheduleStatistics(stats *Statistics) {
+	if late, skipped := stats.getScheduleStats(); late > 0 || skipped > 0 {
+		fmt.Printf(i18n.T().MsgStatisticsSchedule(), late, skipped)
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+func TestProbeScheduleDue(t *testing.T) {
+	start := time.Now()
+	s := newProbeSchedule(&Options{Interval: 100}, start)
+	for slot := 0; slot < 5; slot++ {
+		if got := s.due(slot).Sub(start); got != time.Duration(slot)*100*time.Millisecond {
+			t.Errorf("due(%d) = +%v", slot, got)
+		}
+	}
+
+	s = newProbeSchedule(&Options{Interval: 100, IntervalJitter: 50 * time.Millisecond}, start)
+	if !s.due(0).Equal(start) {
+		t.Error("the first probe should not be delayed by jitter")
+	}
+	varied := false
+	for slot := 1; slot < 50; slot++ {
+		offset := s.due(slot).Sub(start) - time.Duration(slot)*100*time.Millisecond
+		if offset < 0 || offset >= 50*time.Millisecond {
+			t.Fatalf("slot %d offset %v outside [0, 50ms)", slot, offset)
+		}
+		if !s.due(slot).Equal(s.due(slot)) {
+			t.Fatalf("slot %d offset is not stable", slot)
+		}
+		varied = varied || offset != s.due(1).Sub(start)-100*time.Millisecond
+	}
+	if !varied {
+		t.Error("jitter should vary between slots")
+	}
+}
+
+func TestProbeScheduleMissedAndLate(t *testing.T) {
+	start := time.Now()
+	s := newProbeSchedule(&Options{Interval: 100}, start)
+	if n := s.missed(1, start.Add(150*time.Millisecond)); n != 0 {
+		t.Errorf("missed = %d, want 0", n)
+	}
+	if n := s.missed(1, start.Add(350*time.Millisecond)); n != 2 {
+		t.Errorf("missed = %d, want 2", n)
+	}
+	if d := s.late(1, start.Add(105*time.Millisecond)); d != 0 {
+		t.Errorf("late within tolerance = %v, want 0", d)
+	}
+	if d := s.late(1, start.Add(150*time.Millisecond)); d != 50*time.Millisecond {
+		t.Errorf("late = %v, want 50ms", d)
+	}
+	s = newProbeSchedule(&Options{}, start)
+	if s.missed(1, start.Add(time.Second)) != 0 || s.late(1, start.Add(time.Second)) != 0 {
+		t.Error("a zero interval has no fixed slots")
+	}
+}
+
+func TestMaxInFlight(t *testing.T) {
+	tests := []struct {
+		opts Options
+		want int
+	}{
+		{Options{Interval: 1000, Timeout: 1000}, 1},
+		{Options{Interval: 1000, Timeout: 900}, 1},
+		{Options{Interval: 200, Timeout: 1000}, 5},
+		{Options{Interval: 300, Timeout: 1000}, 4},
+		{Options{Interval: 200, Timeout: 1000, MaxInFlight: 2}, 2},
+		{Options{Interval: 200, Timeout: 1000, Persistent: true}, 1},
+		{Options{Interval: 200, Timeout: 1000, CompareICMP: true}, 1},
+		{Options{Interval: 200, Timeout: 1000, HTTPMode: true}, 5},
+		{Options{Interval: 200, Timeout: 1000, HTTPMode: true, HTTP2: true}, 1},
+		{Options{Interval: 200, Timeout: 1000, HTTPMode: true, HTTP3: true}, 1},
+		{Options{Interval: 200, Timeout: 1000, GRPC: true}, 5},
+		{Options{Timeout: 1000}, 1},
+	}
+	for _, tt := range tests {
+		if got := maxInFlight(&tt.opts); got != tt.want {
+			t.Errorf("maxInFlight(%+v) = %d, want %d", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestRunProbeLoopFixedCadence(t *testing.T) {
+	var maxInFlight int64
+	opts := &Options{Count: 5, Interval: 100, Timeout: 100}
+	stats := &Statistics{}
+	start := time.Now()
+	runProbeLoop(context.Background(), opts, stats, sleepProbe(40*time.Millisecond, &maxInFlight), func(probeResult) {})
+	// 时间点固定为 0、100、…、400ms，探测耗时不累加到间隔上
+	if elapsed := time.Since(start); elapsed < 430*time.Millisecond || elapsed > 580*time.Millisecond {
+		t.Errorf("elapsed = %v, want about 440ms", elapsed)
+	}
+	if late, skipped := stats.getScheduleStats(); late != 0 || skipped != 0 {
+		t.Errorf("late/skipped = %d/%d, want 0/0", late, skipped)
+	}
+}
+
+func TestRunProbeLoopOverlapsSlowProbes(t *testing.T) {
+	var maxInFlight, results int64
+	opts := &Options{Count: 6, Interval: 50, Timeout: 1000}
+	stats := &Statistics{}
+	start := time.Now()
+	runProbeLoop(context.Background(), opts, stats, sleepProbe(120*time.Millisecond, &maxInFlight),
+		func(probeResult) { atomic.AddInt64(&results, 1) })
+	if results != 6 || maxInFlight < 2 {
+		t.Errorf("results/max in flight = %d/%d, want 6/≥2", results, maxInFlight)
+	}
+	// 依次探测需要 6×120ms 加上间隔，固定时间点只需 5×50ms 加上最后一次探测
+	if elapsed := time.Since(start); elapsed > 750*time.Millisecond {
+		t.Errorf("elapsed = %v, slow probes should not delay later slots", elapsed)
+	}
+	if late, skipped := stats.getScheduleStats(); late != 0 || skipped != 0 {
+		t.Errorf("late/skipped = %d/%d, want 0/0", late, skipped)
+	}
+}
+
+func TestRunProbeLoopSkipsSlots(t *testing.T) {
+	i18n.Initialize("en-US")
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	var maxInFlight int64
+	opts := &Options{Count: 3, Interval: 50, Timeout: 50, VerboseMode: true}
+	stats := &Statistics{}
+	runProbeLoop(context.Background(), opts, stats, sleepProbe(120*time.Millisecond, &maxInFlight), func(probeResult) {})
+	late, skipped := stats.getScheduleStats()
+	if maxInFlight != 1 || late == 0 || skipped == 0 {
+		t.Errorf("max in flight/late/skipped = %d/%d/%d", maxInFlight, late, skipped)
+	}
+	if out := buf.String(); !strings.Contains(out, "Skipped") || !strings.Contains(out, "behind schedule") {
+		t.Errorf("output %q should report skipped and late slots", out)
+	}
+}
+
+func TestRunProbeLoopStopsWithProbesInFlight(t *testing.T) {
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	var results int64
+	opts := &Options{Interval: 10, Timeout: 1000, UntilFailure: true}
+	probe := func(ctx context.Context, seq int) probeResult {
+		time.Sleep(30 * time.Millisecond)
+		return probeResult{seq: seq, success: seq < 3}
+	}
+	reason := runProbeLoop(context.Background(), opts, &Statistics{}, probe, func(probeResult) { atomic.AddInt64(&results, 1) })
+	if reason != stopUntilFailure {
+		t.Errorf("reason = %v, want until-failure", reason)
+	}
+	// 停止时仍在进行的探测完成后才返回，之后不再发出新的探测
+	if n := atomic.LoadInt64(&results); n < 4 || n > 8 {
+		t.Errorf("results = %d", n)
+	}
+}
+
+func TestValidateScheduleOptions(t *testing.T) {
+	if err := validateModes(&Options{Interval: 100, IntervalJitter: 200 * time.Millisecond}); err == nil {
+		t.Error("jitter larger than the interval should be rejected")
+	}
+	if err := validateModes(&Options{Interval: 100, IntervalJitter: 100 * time.Millisecond}); err != nil {
+		t.Errorf("jitter equal to the interval rejected: %v", err)
+	}
+	if err := validateModes(&Options{Persistent: true, MaxInFlight: 2}); err == nil || !strings.Contains(err.Error(), "--persistent") {
+		t.Errorf("--max-inflight with --persistent: %v", err)
+	}
+	if err := validateModes(&Options{HTTPMode: true, MaxInFlight: 2}); err != nil {
+		t.Errorf("--max-inflight with -H rejected: %v", err)
+	}
+	if err := validateModes(&Options{HTTPMode: true, HTTP2: true, MaxInFlight: 2}); err == nil || !strings.Contains(err.Error(), "--http2") {
+		t.Errorf("--max-inflight with --http2: %v", err)
+	}
+	if err := validateModes(&Options{Rate: 10, Interval: 1000, IntervalJitter: time.Millisecond}); err == nil {
+		t.Error("--interval-jitter with --rate should be rejected")
+	}
+}
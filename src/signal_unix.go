@@ -0,0 +1,11 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// 触发阶段统计输出的信号，与 iputils ping 一致
+var summarySignals = []os.Signal{syscall.SIGQUIT, syscall.SIGUSR1}
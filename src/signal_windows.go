@@ -0,0 +1,8 @@
+//go:build windows
+
+package main
+
+import "os"
+
+// Windows 没有 SIGQUIT/SIGUSR1，阶段统计只能通过 --summary-every 定时输出
+var summarySignals []os.Signal
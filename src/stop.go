@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// stopReason 表示探测循环提前结束的原因
+type stopReason int
+
+const (
+	stopNone         stopReason = iota // 完成全部次数或被用户中断
+	stopDuration                       // 达到 --duration
+	stopUntilSuccess                   // --until-success 收到成功响应
+	stopUntilFailure                   // --until-failure 出现失败
+	stopMaxFailures                    // 失败次数达到 --max-failures
+)
+
+func (r stopReason) String() string {
+	switch r {
+	case stopDuration:
+		return "duration"
+	case stopUntilSuccess:
+		return "until-success"
+	case stopUntilFailure:
+		return "until-failure"
+	case stopMaxFailures:
+		return "max-failures"
+	}
+	return "none"
+}
+
+// hasStopCondition 判断是否设置了任一停止条件，设置后未指定 -n 时持续探测
+func hasStopCondition(opts *Options) bool {
+	return opts.Duration > 0 || opts.UntilSuccess || opts.UntilFailure || opts.MaxFailures > 0
+}
+
+// stopTracker 在每次探测后检查停止条件
+type stopTracker struct {
+	opts     *Options
+	deadline time.Time // 零值表示没有时长限制
+	failures int
+}
+
+func newStopTracker(opts *Options, start time.Time) *stopTracker {
+	t := &stopTracker{opts: opts}
+	if opts.Duration > 0 {
+		t.deadline = start.Add(opts.Duration)
+	}
+	return t
+}
+
+// check 根据探测结果判断是否需要停止
+func (t *stopTracker) check(r probeResult) stopReason {
+	if r.success && t.opts.UntilSuccess {
+		return stopUntilSuccess
+	}
+	if !r.success {
+		t.failures++
+		if t.opts.UntilFailure {
+			return stopUntilFailure
+		}
+		if t.opts.MaxFailures > 0 && t.failures >= t.opts.MaxFailures {
+			return stopMaxFailures
+		}
+	}
+	if t.expired(time.Now()) {
+		return stopDuration
+	}
+	return stopNone
+}
+
+func (t *stopTracker) expired(now time.Time) bool {
+	return !t.deadline.IsZero() && !now.Before(t.deadline)
+}
+
+// untilDeadline 返回距离时长限制的剩余时间，没有限制时 ok 为 false
+func (t *stopTracker) untilDeadline(now time.Time) (d time.Duration, ok bool) {
+	if t.deadline.IsZero() {
+		return 0, false
+	}
+	return t.deadline.Sub(now), true
+}
+
+// printStopReason 在统计信息之前说明触发的停止条件
+func printStopReason(reason stopReason, opts *Options) {
+	lang := i18n.T()
+	var msg string
+	switch reason {
+	case stopDuration:
+		msg = fmt.Sprintf(lang.MsgStopDuration(), opts.Duration)
+	case stopUntilSuccess:
+		msg = lang.MsgStopUntilSuccess()
+	case stopUntilFailure:
+		msg = lang.MsgStopUntilFailure()
+	case stopMaxFailures:
+		msg = fmt.Sprintf(lang.MsgStopMaxFailures(), opts.MaxFailures)
+	default:
+		return
+	}
+	fmt.Print(infoText(msg, opts.ColorOutput))
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeProbe 按给定的成功/失败序列返回探测结果
+func fakeProbe(outcomes []bool) func(ctx context.Context, seq int) probeResult {
+	return func(ctx context.Context, seq int) probeResult {
+		ok := outcomes[len(outcomes)-1]
+		if seq < len(outcomes) {
+			ok = outcomes[seq]
+		}
+		return probeResult{seq: seq, time: time.Now(), rtt: 1, success: ok}
+	}
+}
+
+func TestRunProbeLoopStopConditions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		outcomes []bool
+		want     stopReason
+		probes   int
+	}{
+		{"count exhausted", Options{Count: 3}, []bool{true}, stopNone, 3},
+		{"until success", Options{UntilSuccess: true}, []bool{false, false, true}, stopUntilSuccess, 3},
+		{"until failure", Options{UntilFailure: true}, []bool{true, true, false}, stopUntilFailure, 3},
+		{"max failures", Options{MaxFailures: 2}, []bool{false, true, false, true}, stopMaxFailures, 3},
+		{"count before until success", Options{Count: 2, UntilSuccess: true}, []bool{false}, stopNone, 2},
+		{"duration", Options{Duration: 30 * time.Millisecond, Interval: 10}, []bool{true}, stopDuration, -1},
+	}
+	for _, tt := range tests {
+		probes := 0
+		got := runProbeLoop(context.Background(), &tt.opts, &Statistics{}, fakeProbe(tt.outcomes), func(probeResult) { probes++ })
+		if got != tt.want {
+			t.Errorf("%s: reason = %v, want %v", tt.name, got, tt.want)
+		}
+		if tt.probes >= 0 && probes != tt.probes {
+			t.Errorf("%s: probes = %d, want %d", tt.name, probes, tt.probes)
+		}
+	}
+}
+
+func TestSetupFlagsStopConditionDefaultsToContinuous(t *testing.T) {
+	opts := &Options{}
+	if _, err := setupFlags(opts, []string{"--until-success", "host"}); err != nil {
+		t.Fatal(err)
+	}
+	if opts.Count != 0 {
+		t.Errorf("count = %d, want 0 (continuous) with --until-success", opts.Count)
+	}
+
+	opts = &Options{}
+	if _, err := setupFlags(opts, []string{"--duration", "10m", "-n", "5", "host"}); err != nil {
+		t.Fatal(err)
+	}
+	if opts.Count != 5 || opts.Duration != 10*time.Minute {
+		t.Errorf("count = %d duration = %v, want 5 and 10m", opts.Count, opts.Duration)
+	}
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// interimReporter 在不停止探测的情况下输出阶段统计，
+// 由 --summary-every 定时触发或收到 SIGQUIT/SIGUSR1 时触发
+type interimReporter struct {
+	w       io.Writer // 不使用 output，--json 和负载模式下 output 被丢弃
+	stats   *Statistics
+	opts    *Options
+	started time.Time
+	last    statsSnapshot // 上一次输出阶段统计时的快照
+	lastAt  time.Time
+}
+
+func newInterimReporter(stats *Statistics, opts *Options, w io.Writer) *interimReporter {
+	now := time.Now()
+	return &interimReporter{
+		w:       w,
+		stats:   stats,
+		opts:    opts,
+		started: now,
+		last:    stats.snapshot(),
+		lastAt:  now,
+	}
+}
+
+// report 输出累计统计，启用 --summary-window 时再输出最近一个区间的统计
+func (r *interimReporter) report() {
+	lang := i18n.T()
+	now := time.Now()
+	current := r.stats.snapshot()
+
+	fmt.Fprintf(r.w, infoText(lang.MsgInterimTitle(), r.opts.ColorOutput),
+		now.Format("15:04:05"), now.Sub(r.started).Round(100*time.Millisecond))
+	sent, responded, minTime, maxTime, avgTime := r.stats.getStats()
+	printSummaryLines(r.w, sent, responded, minTime, maxTime, avgTime)
+
+	if r.opts.SummaryWindow {
+		fmt.Fprintf(r.w, lang.MsgInterimWindow(), now.Sub(r.lastAt).Round(100*time.Millisecond))
+		sent, responded, minTime, maxTime, avgTime = r.stats.getStatsSince(r.last)
+		printSummaryLines(r.w, sent, responded, minTime, maxTime, avgTime)
+	}
+	fmt.Fprintln(r.w)
+
+	r.last = current
+	r.lastAt = now
+}
+
+// printSummaryLines 输出发送/接收/丢失和往返时间两行统计
+func printSummaryLines(w io.Writer, sent, responded int64, minTime, maxTime, avgTime float64) {
+	if sent == 0 {
+		return
+	}
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Fprintf(w, i18n.T().MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Fprintf(w, i18n.T().MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+}
+
+// jsonSummary 是 --json 模式下输出的最终统计
+type jsonSummary struct {
+	Mode        string           `json:"mode"`
+	Target      string           `json:"target"`
+	Sent        int64            `json:"sent"`
+	Received    int64            `json:"received"`
+	Lost        int64            `json:"lost"`
+	LossPercent float64          `json:"loss_percent"`
+	RTT         *jsonRTT         `json:"rtt_ms,omitempty"`
+	HTTP        *jsonHTTP        `json:"http,omitempty"`
+	Throughput  *jsonThroughput  `json:"throughput,omitempty"`
+	Proxy       *jsonProxy       `json:"proxy,omitempty"`
+	WebSocket   *jsonWebSocket   `json:"websocket,omitempty"`
+	GRPC        *jsonGRPC        `json:"grpc,omitempty"`
+	Proto       *jsonConnLatency `json:"proto_handshake,omitempty"`
+	DNS         *jsonDNS         `json:"dns,omitempty"`
+	NTP         *jsonNTP         `json:"ntp,omitempty"`
+	Persistent  *jsonPersistent  `json:"persistent,omitempty"`
+	IdleTimeout *jsonIdleTimeout `json:"idle_timeout,omitempty"`
+	ICMP        *jsonICMP        `json:"icmp_compare,omitempty"`
+	Load        *jsonLoad        `json:"load,omitempty"`
+	Schedule    *jsonSchedule    `json:"schedule,omitempty"`
+	Adaptive    *jsonAdaptive    `json:"adaptive,omitempty"`
+	StopReason  string           `json:"stop_reason,omitempty"`
+	Verdict     jsonVerdict      `json:"verdict"`
+	ExitCode    int              `json:"exit_code"`
+}
+
+type jsonRTT struct {
+	Min float64 `json:"min"`
+	Avg float64 `json:"avg"`
+	Max float64 `json:"max"`
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+}
+
+type jsonHTTP struct {
+	TotalBytes int64   `json:"total_bytes"`
+	BodyBytes  int64   `json:"body_bytes"`
+	MinGoodput float64 `json:"min_goodput_mbps"`
+	MaxGoodput float64 `json:"max_goodput_mbps"`
+	AvgGoodput float64 `json:"avg_goodput_mbps"`
+	// 复用连接与新建连接的耗时统计
+	ReusedConns *jsonConnLatency `json:"reused_connections,omitempty"`
+	NewConns    *jsonConnLatency `json:"new_connections,omitempty"`
+}
+
+type jsonConnLatency struct {
+	Count int64   `json:"count"`
+	Min   float64 `json:"min_ms"`
+	Avg   float64 `json:"avg_ms"`
+	Max   float64 `json:"max_ms"`
+}
+
+func newJSONConnLatency(g latencyGroup) *jsonConnLatency {
+	if g.count == 0 {
+		return nil
+	}
+	return &jsonConnLatency{Count: g.count, Min: g.min, Avg: g.avg(), Max: g.max}
+}
+
+// jsonProxy 是经代理建立连接时两个阶段的耗时
+type jsonProxy struct {
+	Connect *jsonConnLatency `json:"connect"`
+	Tunnel  *jsonConnLatency `json:"tunnel"`
+}
+
+// jsonWebSocket 是 --ws 模式下的握手耗时和重连次数
+type jsonWebSocket struct {
+	Handshake  *jsonConnLatency `json:"handshake"`
+	Reconnects int64            `json:"reconnects"`
+}
+
+// jsonGRPC 是 --grpc 模式下健康检查返回的各服务状态的次数
+type jsonGRPC struct {
+	Statuses map[string]int64 `json:"statuses"`
+}
+
+// jsonDNS 是 --dns 模式下各响应码的次数和被截断的响应数
+type jsonDNS struct {
+	Rcodes    map[string]int64 `json:"rcodes"`
+	Truncated int64            `json:"truncated"`
+}
+
+// jsonNTP 是 --ntp 模式下时钟偏移的统计，正值表示本地时钟落后
+type jsonNTP struct {
+	Offset *jsonConnLatency `json:"offset"`
+}
+
+// jsonPersistent 是 --persistent 模式下建立连接的耗时、重连次数和断开连接的存活时间
+type jsonPersistent struct {
+	Connect    *jsonConnLatency `json:"connect"`
+	Reconnects int64            `json:"reconnects"`
+	Drops      int64            `json:"drops"`
+	Lifetime   *jsonConnLatency `json:"dropped_lifetime,omitempty"`
+}
+
+// jsonIdleTimeout 是 --find-idle-timeout 的结果：连接在 alive_ms 的空闲后仍然可用，
+// 在 dropped_ms 的空闲后被断开，dropped_ms 为空表示在 --idle-max 内没有断开。
+// inconclusive_ms 是请求超时而无法判断连接是否断开、搜索因此停止时的空闲时间
+type jsonIdleTimeout struct {
+	AliveMs        float64  `json:"alive_ms"`
+	DroppedMs      *float64 `json:"dropped_ms"`
+	InconclusiveMs float64  `json:"inconclusive_ms,omitempty"`
+	Checks         int      `json:"checks"`
+	Complete       bool     `json:"complete"`
+	Error          string   `json:"error,omitempty"`
+}
+
+// jsonICMP 是 --compare-icmp 中与 TCP 探测交替发送的回显请求的结果
+type jsonICMP struct {
+	Sent     int64            `json:"sent"`
+	Received int64            `json:"received"`
+	RTT      *jsonConnLatency `json:"rtt"`
+}
+
+// jsonSchedule 是晚于计划时间发出的探测数和被跳过的时间点数
+type jsonSchedule struct {
+	Late    int64 `json:"late"`
+	Skipped int64 `json:"skipped"`
+}
+
+// jsonAdaptive 是 --adaptive 使用的超时和间隔的范围，以及每次探测使用的值
+type jsonAdaptive struct {
+	Timeout  *jsonConnLatency    `json:"timeout"`
+	Interval *jsonConnLatency    `json:"interval"`
+	Probes   []jsonAdaptiveProbe `json:"probes"`
+}
+
+type jsonAdaptiveProbe struct {
+	Seq      int     `json:"seq"`
+	Timeout  float64 `json:"timeout_ms"`
+	Interval float64 `json:"interval_ms"`
+}
+
+// jsonLoad 是 --rate/--concurrency 负载模式的实际速率、错误分类和每秒的区间统计
+type jsonLoad struct {
+	TargetRate   float64            `json:"target_rate"`
+	Concurrency  int                `json:"concurrency"`
+	Launched     int64              `json:"launched"`
+	Skipped      int64              `json:"skipped"`
+	DurationMs   float64            `json:"duration_ms"`
+	AchievedRate float64            `json:"achieved_rate"`
+	Errors       map[string]int64   `json:"errors"`
+	Intervals    []jsonLoadInterval `json:"intervals"`
+}
+
+type jsonLoadInterval struct {
+	AtMs     float64          `json:"at_ms"`
+	Launched int64            `json:"launched"`
+	OK       int64            `json:"ok"`
+	Failed   int64            `json:"failed"`
+	P50      float64          `json:"p50_ms"`
+	P99      float64          `json:"p99_ms"`
+	Errors   map[string]int64 `json:"errors,omitempty"`
+}
+
+type jsonThroughput struct {
+	Direction     string                 `json:"direction"`
+	Streams       int                    `json:"streams"`
+	FailedStreams int                    `json:"failed_streams"`
+	Bytes         int64                  `json:"bytes"`
+	DurationMs    float64                `json:"duration_ms"`
+	RampUpMs      float64                `json:"ramp_up_ms"`
+	SustainedMin  float64                `json:"sustained_min_mbps"`
+	SustainedAvg  float64                `json:"sustained_avg_mbps"`
+	SustainedMax  float64                `json:"sustained_max_mbps"`
+	FinalMbps     float64                `json:"final_mbps"`
+	Samples       []jsonThroughputSample `json:"samples"`
+}
+
+type jsonThroughputSample struct {
+	AtMs  float64 `json:"at_ms"`
+	Bytes int64   `json:"bytes"`
+	Mbps  float64 `json:"mbps"`
+}
+
+type jsonVerdict struct {
+	Checked  bool     `json:"thresholds_checked"`
+	Pass     bool     `json:"pass"`
+	Failures []string `json:"failures,omitempty"`
+}
+
+// buildJSONSummary 汇总统计、停止原因和判定结果
+func buildJSONSummary(mode, target string, stats *Statistics, reason stopReason, v verdict) jsonSummary {
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	summary := jsonSummary{
+		Mode:     mode,
+		Target:   target,
+		Sent:     sent,
+		Received: responded,
+		Lost:     sent - responded,
+		Verdict:  jsonVerdict{Checked: v.checked, Pass: v.pass, Failures: v.failures},
+		ExitCode: v.exitCode,
+	}
+	if sent > 0 {
+		summary.LossPercent = float64(sent-responded) / float64(sent) * 100
+	}
+	if reason != stopNone {
+		summary.StopReason = reason.String()
+	}
+	if responded > 0 {
+		p := stats.getPercentiles(50, 90, 99)
+		summary.RTT = &jsonRTT{
+			Min: minTime,
+			Avg: avgTime,
+			Max: maxTime,
+			P50: p[0],
+			P90: p[1],
+			P99: p[2],
+		}
+	}
+	if connect, tunnel := stats.getProxyStats(); connect.count > 0 {
+		summary.Proxy = &jsonProxy{Connect: newJSONConnLatency(connect), Tunnel: newJSONConnLatency(tunnel)}
+	}
+	if mode == "ws" {
+		handshakes, reconnects := stats.getWSStats()
+		summary.WebSocket = &jsonWebSocket{Handshake: newJSONConnLatency(handshakes), Reconnects: reconnects}
+	}
+	summary.Proto = newJSONConnLatency(stats.getProtoStats())
+	if mode == "dns" {
+		rcodes, truncated := stats.getDNSStats()
+		summary.DNS = &jsonDNS{Rcodes: rcodes, Truncated: truncated}
+	}
+	if mode == "ntp" {
+		summary.NTP = &jsonNTP{Offset: newJSONConnLatency(stats.getNTPOffset())}
+	}
+	if mode == "persistent" {
+		connects, reconnects, drops := stats.getPersistentStats()
+		summary.Persistent = &jsonPersistent{Connect: newJSONConnLatency(connects), Reconnects: reconnects,
+			Drops: drops.count, Lifetime: newJSONConnLatency(drops)}
+	}
+	if icmpSent, icmp := stats.getICMPCompare(); icmpSent > 0 {
+		summary.ICMP = &jsonICMP{Sent: icmpSent, Received: icmp.count, RTT: newJSONConnLatency(icmp)}
+	}
+	if late, skipped := stats.getScheduleStats(); late > 0 || skipped > 0 {
+		summary.Schedule = &jsonSchedule{Late: late, Skipped: skipped}
+	}
+	if timeout, interval := stats.getAdaptiveStats(); timeout.count > 0 {
+		summary.Adaptive = &jsonAdaptive{Timeout: newJSONConnLatency(timeout), Interval: newJSONConnLatency(interval)}
+		for _, p := range stats.getAdaptiveProbes() {
+			summary.Adaptive.Probes = append(summary.Adaptive.Probes, jsonAdaptiveProbe{
+				Seq:      p.seq,
+				Timeout:  durationMillis(p.timeout),
+				Interval: durationMillis(p.interval),
+			})
+		}
+	}
+	if mode == "grpc" {
+		summary.GRPC = &jsonGRPC{Statuses: stats.getGRPCStatuses()}
+	}
+	if mode == "http" {
+		_, _, totalBytes, _, _, _, minBW, maxBW, avgBW := stats.getHTTPStats()
+		summary.HTTP = &jsonHTTP{TotalBytes: totalBytes, BodyBytes: stats.getBodyBytes(), MinGoodput: minBW, MaxGoodput: maxBW, AvgGoodput: avgBW}
+		reused, fresh := stats.getHTTPConnStats()
+		summary.HTTP.ReusedConns = newJSONConnLatency(reused)
+		summary.HTTP.NewConns = newJSONConnLatency(fresh)
+	}
+	return summary
+}
+
+// printJSONSummary 以 JSON 格式输出最终统计
+func printJSONSummary(summary jsonSummary) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.Encode(summary)
+}
+
+// json 将吞吐量测试结果转换为 JSON 输出结构
+func (r *throughputResult) json() *jsonThroughput {
+	direction := "download"
+	if r.upload {
+		direction = "upload"
+	}
+	t := &jsonThroughput{
+		Direction:     direction,
+		Streams:       r.streams,
+		FailedStreams: r.failed,
+		Bytes:         r.bytes,
+		DurationMs:    durationMillis(r.elapsed),
+		RampUpMs:      durationMillis(r.rampUp()),
+		FinalMbps:     r.finalMbps(),
+		Samples:       []jsonThroughputSample{},
+	}
+	t.SustainedMin, t.SustainedAvg, t.SustainedMax, _ = r.sustained()
+	for _, s := range r.samples {
+		t.Samples = append(t.Samples, jsonThroughputSample{AtMs: durationMillis(s.at), Bytes: s.bytes, Mbps: s.mbps})
+	}
+	return t
+}
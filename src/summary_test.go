@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"tcping/src/i18n"
+)
+
+func TestStatisticsGetStatsSince(t *testing.T) {
+	var s Statistics
+	s.update(10, true)
+	s.update(0, false)
+	snap := s.snapshot()
+	s.update(30, true)
+	s.update(0, false)
+	s.update(20, true)
+
+	sent, responded, min, max, avg := s.getStatsSince(snap)
+	if sent != 3 || responded != 2 {
+		t.Errorf("window sent/responded = %d/%d, want 3/2", sent, responded)
+	}
+	if min != 20 || max != 30 || avg != 25 {
+		t.Errorf("window min/max/avg = %v/%v/%v, want 20/30/25", min, max, avg)
+	}
+
+	sent, responded, _, _, _ = s.getStatsSince(s.snapshot())
+	if sent != 0 || responded != 0 {
+		t.Errorf("empty window sent/responded = %d/%d, want 0/0", sent, responded)
+	}
+}
+
+func TestInterimReporter(t *testing.T) {
+	i18n.Initialize("en-US")
+	var buf bytes.Buffer
+	// --json 和负载模式下 output 被丢弃，阶段统计仍然输出
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+
+	stats := &Statistics{}
+	r := newInterimReporter(stats, &Options{SummaryWindow: true}, &buf)
+	stats.update(10, true)
+	stats.update(0, false)
+	r.report()
+	stats.update(30, true)
+	buf.Reset()
+	r.report()
+
+	got := buf.String()
+	if !strings.Contains(got, "Sent = 3, Received = 2") {
+		t.Errorf("cumulative summary missing:\n%s", got)
+	}
+	if !strings.Contains(got, "Sent = 1, Received = 1") {
+		t.Errorf("window summary missing:\n%s", got)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !(linux || darwin || freebsd)
+
+package main
+
+import "os"
+
+// terminalSize 在无法查询终端尺寸的平台上始终返回 false，
+// 全屏视图会回退到逐行输出
+func terminalSize(f *os.File) (width, height int, ok bool) {
+	return 0, 0, false
+}
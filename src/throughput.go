@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+const (
+	// 吞吐量采样间隔
+	throughputInterval = 250 * time.Millisecond
+	// 采样首次达到峰值的该比例时视为爬升阶段结束
+	rampUpRatio = 0.9
+)
+
+// throughputSample 是一个采样区间内的吞吐量
+type throughputSample struct {
+	at    time.Duration // 区间结束时相对测试开始的时间
+	bytes int64         // 区间内传输的字节数
+	mbps  float64
+}
+
+// throughputResult 汇总一次吞吐量测试
+type throughputResult struct {
+	upload  bool
+	streams int
+	failed  int // 失败的传输流数量
+	bytes   int64
+	elapsed time.Duration
+	samples []throughputSample
+	reason  stopReason
+}
+
+func (r *throughputResult) finalMbps() float64 {
+	if r.elapsed <= 0 {
+		return 0
+	}
+	return float64(r.bytes*8) / r.elapsed.Seconds() / 1e6
+}
+
+// rampUpIndex 返回第一个达到峰值 rampUpRatio 的采样下标，没有采样时返回 -1
+func (r *throughputResult) rampUpIndex() int {
+	var peak float64
+	for _, s := range r.samples {
+		if s.mbps > peak {
+			peak = s.mbps
+		}
+	}
+	for i, s := range r.samples {
+		if s.mbps >= peak*rampUpRatio {
+			return i
+		}
+	}
+	return -1
+}
+
+// rampUp 返回从开始传输到吞吐量接近峰值所用的时间
+func (r *throughputResult) rampUp() time.Duration {
+	i := r.rampUpIndex()
+	if i <= 0 {
+		return 0
+	}
+	return r.samples[i-1].at
+}
+
+// sustained 返回爬升阶段之后各采样的最小、平均和最大吞吐量
+func (r *throughputResult) sustained() (min, avg, max float64, ok bool) {
+	i := r.rampUpIndex()
+	if i < 0 {
+		return 0, 0, 0, false
+	}
+	steady := r.samples[i:]
+	for j, s := range steady {
+		if j == 0 || s.mbps < min {
+			min = s.mbps
+		}
+		if j == 0 || s.mbps > max {
+			max = s.mbps
+		}
+		avg += s.mbps
+	}
+	avg /= float64(len(steady))
+	return min, avg, max, true
+}
+
+// countingReader 统计经过的字节数，用于下载的响应体和上传的负载
+type countingReader struct {
+	r io.Reader
+	n *int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	atomic.AddInt64(c.n, int64(n))
+	return n, err
+}
+
+// payloadReader 循环输出一块随机数据作为上传负载，避免被链路压缩
+type payloadReader struct {
+	block []byte
+	off   int
+}
+
+func newPayloadReader() *payloadReader {
+	block := make([]byte, 64*1024)
+	rand.Read(block)
+	return &payloadReader{block: block}
+}
+
+func (p *payloadReader) Read(b []byte) (int, error) {
+	n := copy(b, p.block[p.off:])
+	p.off = (p.off + n) % len(p.block)
+	return n, nil
+}
+
+// runThroughput 使用 opts.Streams 个并行流下载 uri（或上传 opts.UploadSize 字节），
+// 每 throughputInterval 采样一次总吞吐量。每个传输流作为一次探测计入 stats
+func runThroughput(ctx context.Context, uri string, opts *Options, stats *Statistics,
+	onResult func(probeResult)) *throughputResult {
+	result := &throughputResult{upload: opts.UploadSize > 0, streams: opts.Streams}
+	if opts.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Duration)
+		defer cancel()
+	}
+
+	probeClient := newHTTPProbeClient(opts)
+
+	direction := i18n.T().MsgThroughputDownload()
+	if result.upload {
+		direction = i18n.T().MsgThroughputUpload()
+	}
+	fmt.Fprintf(output, i18n.T().MsgThroughputStart(), direction, opts.Streams, throughputInterval)
+
+	var transferred int64
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < opts.Streams; i++ {
+		wg.Add(1)
+		go func(seq int) {
+			defer wg.Done()
+			r := throughputStream(ctx, probeClient, uri, opts, stats, seq, &transferred)
+			mu.Lock()
+			defer mu.Unlock()
+			if !r.success {
+				result.failed++
+			}
+			onResult(r)
+		}(i)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	ticker := time.NewTicker(throughputInterval)
+	defer ticker.Stop()
+	var last int64
+	lastAt := start
+sampling:
+	for {
+		select {
+		case <-done:
+			break sampling
+		case now := <-ticker.C:
+			cur := atomic.LoadInt64(&transferred)
+			s := throughputSample{
+				at:    now.Sub(start),
+				bytes: cur - last,
+				mbps:  float64((cur-last)*8) / now.Sub(lastAt).Seconds() / 1e6,
+			}
+			result.samples = append(result.samples, s)
+			fmt.Fprintf(output, i18n.T().MsgThroughputSample(), s.at.Seconds(), s.mbps, s.bytes)
+			last, lastAt = cur, now
+		}
+	}
+
+	result.elapsed = time.Since(start)
+	result.bytes = atomic.LoadInt64(&transferred)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		result.reason = stopDuration
+	}
+	return result
+}
+
+// throughputStream 执行单个传输流。被中断或达到 --duration 时，
+// 已传输的数据仍然有效，该流按成功计
+func throughputStream(ctx context.Context, probeClient *httpProbeClient, uri string, opts *Options,
+	stats *Statistics, seq int, transferred *int64) probeResult {
+	result := probeResult{seq: seq, time: time.Now()}
+	fail := func(elapsed float64, err error) probeResult {
+		stats.updateHTTP(elapsed, 0, false)
+		fmt.Fprint(output, errorText(fmt.Sprintf(i18n.T().MsgThroughputStreamFailed(), seq, err), opts.ColorOutput))
+		result.rtt = elapsed
+		result.err = err.Error()
+		return result
+	}
+
+	method := "GET"
+	var body io.Reader
+	if opts.UploadSize > 0 {
+		method = "POST"
+		body = &countingReader{r: io.LimitReader(newPayloadReader(), opts.UploadSize), n: transferred}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, uri, body)
+	if err != nil {
+		return fail(0, err)
+	}
+	if opts.UploadSize > 0 {
+		req.ContentLength = opts.UploadSize
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	req.Header.Set("User-Agent", "tcping/"+version+"."+gitHash)
+
+	wireBefore := probeClient.received()
+	start := time.Now()
+	stopped := func() bool { return ctx.Err() != nil }
+
+	resp, err := probeClient.client.Do(req)
+	elapsed := float64(time.Since(start).Microseconds()) / 1000.0
+	if err != nil && !stopped() {
+		return fail(elapsed, err)
+	}
+	var bodyBytes int64
+	if err == nil {
+		defer resp.Body.Close()
+		if resp.StatusCode >= 400 {
+			return fail(elapsed, fmt.Errorf("HTTP %s", resp.Status))
+		}
+		var dst io.Reader = resp.Body
+		if opts.UploadSize == 0 {
+			dst = &countingReader{r: resp.Body, n: transferred}
+		}
+		bodyBytes, err = io.Copy(io.Discard, dst)
+		if err != nil && !stopped() {
+			return fail(elapsed, err)
+		}
+	}
+
+	total := float64(time.Since(start).Microseconds()) / 1000.0
+	wireBytes := probeClient.received() - wireBefore
+	stats.updateHTTPTransfer(elapsed, total, wireBytes, bodyBytes, true)
+	result.rtt = elapsed
+	result.success = true
+	if opts.VerboseMode {
+		sent := bodyBytes
+		if opts.UploadSize > 0 {
+			sent = opts.UploadSize
+		}
+		fmt.Fprintf(output, i18n.T().MsgThroughputStreamDone(), seq, sent,
+			time.Since(start).Round(time.Millisecond))
+	}
+	return result
+}
+
+// printThroughputStatistics 输出吞吐量测试结果
+func printThroughputStatistics(r *throughputResult, stats *Statistics) {
+	lang := i18n.T()
+	direction := lang.MsgThroughputDownload()
+	if r.upload {
+		direction = lang.MsgThroughputUpload()
+	}
+	fmt.Print(lang.MsgThroughputStatisticsTitle())
+	fmt.Printf(lang.MsgThroughputStreams(), r.streams, r.streams-r.failed, r.failed)
+	fmt.Printf(lang.MsgThroughputTransferred(), direction, r.bytes, float64(r.bytes)/1024/1024,
+		r.elapsed.Round(time.Millisecond))
+	if min, avg, max, ok := r.sustained(); ok {
+		fmt.Printf(lang.MsgThroughputRampUp(), r.rampUp())
+		fmt.Printf(lang.MsgThroughputSustained(), min, max, avg)
+	}
+	fmt.Printf(lang.MsgThroughputFinal(), r.finalMbps())
+	if _, responded, _, _, _ := stats.getStats(); responded > 0 {
+		_, _, _, minTime, maxTime, avgTime, _, _, _ := stats.getHTTPStats()
+		fmt.Printf(lang.MsgThroughputTTFB(), minTime, maxTime, avgTime)
+	}
+}
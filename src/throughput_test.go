@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThroughputResultAnalysis(t *testing.T) {
+	r := &throughputResult{bytes: 1250000, elapsed: time.Second}
+	for i, mbps := range []float64{10, 50, 95, 100, 98} {
+		r.samples = append(r.samples, throughputSample{at: time.Duration(i+1) * throughputInterval, mbps: mbps})
+	}
+	if got := r.rampUp(); got != 500*time.Millisecond {
+		t.Errorf("rampUp = %v, want 500ms", got)
+	}
+	min, avg, max, ok := r.sustained()
+	if !ok || min != 95 || max != 100 || avg < 97.6 || avg > 97.7 {
+		t.Errorf("sustained = %v %v %v %v, want 95 ~97.67 100", min, avg, max, ok)
+	}
+	if got := r.finalMbps(); got != 10 {
+		t.Errorf("finalMbps = %v, want 10", got)
+	}
+
+	empty := &throughputResult{}
+	if _, _, _, ok := empty.sustained(); ok || empty.rampUp() != 0 || empty.finalMbps() != 0 {
+		t.Error("result without samples should report nothing sustained")
+	}
+}
+
+// throughputOpts 返回吞吐量测试使用的选项并丢弃逐行输出
+func throughputOpts(t *testing.T, streams int, upload int64) *Options {
+	t.Helper()
+	output = io.Discard
+	t.Cleanup(func() { output = os.Stdout })
+	return &Options{Timeout: 5000, Streams: streams, UploadSize: upload, Throughput: true}
+}
+
+func TestRunThroughputDownload(t *testing.T) {
+	chunk := strings.Repeat("d", 64*1024)
+	const chunks = 8
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 分块慢速发送，保证测试期间至少产生一次采样
+		for i := 0; i < chunks; i++ {
+			io.WriteString(w, chunk)
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer srv.Close()
+
+	stats := &Statistics{}
+	var results int
+	r := runThroughput(context.Background(), srv.URL, throughputOpts(t, 2, 0), stats, func(probeResult) { results++ })
+	if want := int64(2 * chunks * len(chunk)); r.bytes != want {
+		t.Errorf("bytes = %d, want %d", r.bytes, want)
+	}
+	if r.failed != 0 || results != 2 {
+		t.Errorf("failed = %d, results = %d", r.failed, results)
+	}
+	if len(r.samples) == 0 {
+		t.Error("expected at least one throughput sample")
+	}
+	if _, responded, _, _, _ := stats.getStats(); responded != 2 {
+		t.Errorf("responded = %d, want 2", responded)
+	}
+}
+
+func TestRunThroughputUpload(t *testing.T) {
+	var received int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		n, _ := io.Copy(io.Discard, r.Body)
+		atomic.AddInt64(&received, n)
+	}))
+	defer srv.Close()
+
+	const size = 3 << 20
+	r := runThroughput(context.Background(), srv.URL, throughputOpts(t, 1, size), &Statistics{}, func(probeResult) {})
+	if !r.upload || r.bytes != size || atomic.LoadInt64(&received) != size {
+		t.Errorf("upload bytes = %d, server received %d, want %d", r.bytes, received, size)
+	}
+}
+
+func TestRunThroughputStopsAtDuration(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for r.Context().Err() == nil {
+			io.WriteString(w, strings.Repeat("x", 1024))
+			w.(http.Flusher).Flush()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	defer srv.Close()
+
+	opts := throughputOpts(t, 1, 0)
+	opts.Duration = 300 * time.Millisecond
+	stats := &Statistics{}
+	r := runThroughput(context.Background(), srv.URL, opts, stats, func(probeResult) {})
+	if r.reason != stopDuration {
+		t.Errorf("reason = %v, want duration", r.reason)
+	}
+	// 达到时长限制而中断的传输流不算失败
+	if r.failed != 0 || r.bytes == 0 {
+		t.Errorf("failed = %d, bytes = %d", r.failed, r.bytes)
+	}
+}
+
+func TestRunThroughputHTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	stats := &Statistics{}
+	r := runThroughput(context.Background(), srv.URL, throughputOpts(t, 1, 0), stats, func(probeResult) {})
+	if r.failed != 1 {
+		t.Errorf("failed = %d, want 1", r.failed)
+	}
+	if _, responded, _, _, _ := stats.getStats(); responded != 0 {
+		t.Errorf("responded = %d, want 0", responded)
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"tcping/src/i18n"
+)
+
+// parseTLSVersion 解析 --tls-min/--tls-max 的版本号，如 1.2、TLS1.3
+func parseTLSVersion(s string) (uint16, error) {
+	v := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "tls")
+	switch strings.TrimPrefix(v, "v") {
+	case "1.0", "1":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("unknown TLS version %q", s)
+}
+
+// parseCipherSuites 解析逗号分隔的密码套件名称（Go/IANA 名称，如
+// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256），包括不安全的套件
+func parseCipherSuites(s string) ([]uint16, error) {
+	known := make(map[string]uint16)
+	for _, c := range tls.CipherSuites() {
+		known[c.Name] = c.ID
+	}
+	for _, c := range tls.InsecureCipherSuites() {
+		known[c.Name] = c.ID
+	}
+	var ids []uint16
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no cipher suites")
+	}
+	return ids, nil
+}
+
+// loadTLSConfig 根据 --cacert、--cert/--key、--tls-min/--tls-max、--ciphers
+// 和 --sni 生成 HTTP 探测使用的 TLS 配置，保存到 opts.tlsConfig
+func loadTLSConfig(opts *Options) error {
+	lang := i18n.T()
+	cfg := &tls.Config{
+		InsecureSkipVerify: opts.InsecureSSL,
+		ServerName:         opts.SNI,
+		MinVersion:         opts.TLSMin,
+		MaxVersion:         opts.TLSMax,
+		CipherSuites:       opts.Ciphers,
+	}
+	if cfg.MaxVersion != 0 && cfg.MaxVersion < tls.VersionTLS12 && cfg.MinVersion == 0 {
+		// Go 客户端默认最低为 TLS 1.2，显式要求旧版本时同时放宽下限
+		cfg.MinVersion = tls.VersionTLS10
+	}
+
+	if opts.CACert != "" {
+		pem, err := os.ReadFile(opts.CACert)
+		if err != nil {
+			return fmt.Errorf(lang.ErrorTLSLoadCACert(), opts.CACert, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf(lang.ErrorTLSNoCACerts(), opts.CACert)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if opts.Key != "" && opts.Cert == "" {
+		return errors.New(lang.ErrorTLSKeyWithoutCert())
+	}
+	if opts.Cert != "" {
+		// 与 curl 一致，未指定 --key 时从证书文件中读取私钥
+		key := opts.Key
+		if key == "" {
+			key = opts.Cert
+		}
+		cert, err := tls.LoadX509KeyPair(opts.Cert, key)
+		if err != nil {
+			return fmt.Errorf(lang.ErrorTLSLoadClientCert(), err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	opts.tlsConfig = cfg
+	return nil
+}
+
+// probeTLSConfig 返回新建传输层使用的 TLS 配置副本
+func probeTLSConfig(opts *Options) *tls.Config {
+	if opts.tlsConfig != nil {
+		return opts.tlsConfig.Clone()
+	}
+	return &tls.Config{InsecureSkipVerify: opts.InsecureSSL}
+}
+
+// describeTLSError 把证书验证和握手失败转换为具体原因，
+// 不是 TLS 错误时返回空字符串
+func describeTLSError(err error) string {
+	lang := i18n.T()
+
+	var unknownAuthority x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthority) {
+		issuer := "?"
+		if unknownAuthority.Cert != nil {
+			issuer = unknownAuthority.Cert.Issuer.String()
+		}
+		return fmt.Sprintf(lang.ErrorTLSUnknownAuthority(), issuer)
+	}
+
+	var hostname x509.HostnameError
+	if errors.As(err, &hostname) {
+		return fmt.Sprintf(lang.ErrorTLSHostname(), hostname.Host, strings.Join(certNames(hostname.Certificate), ", "))
+	}
+
+	var invalid x509.CertificateInvalidError
+	if errors.As(err, &invalid) {
+		if invalid.Reason == x509.Expired && invalid.Cert != nil {
+			return fmt.Sprintf(lang.ErrorTLSExpired(),
+				invalid.Cert.NotBefore.Format("2006-01-02 15:04:05 MST"),
+				invalid.Cert.NotAfter.Format("2006-01-02 15:04:05 MST"))
+		}
+		return fmt.Sprintf(lang.ErrorTLSInvalidCert(), invalid)
+	}
+
+	var recordHeader tls.RecordHeaderError
+	if errors.As(err, &recordHeader) {
+		return lang.ErrorTLSNotTLS()
+	}
+
+	// 服务端发送的告警，如缺少客户端证书、版本或密码套件不匹配
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "remote error" {
+		return fmt.Sprintf(lang.ErrorTLSRemoteAlert(), opErr.Err)
+	}
+	return ""
+}
+
+// certNames 返回证书适用的主机名和IP地址
+func certNames(cert *x509.Certificate) []string {
+	if cert == nil {
+		return nil
+	}
+	names := append([]string(nil), cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	if len(names) == 0 && cert.Subject.CommonName != "" {
+		names = append(names, cert.Subject.CommonName)
+	}
+	return names
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTLSVersion(t *testing.T) {
+	tests := []struct {
+		in   string
+		want uint16
+		ok   bool
+	}{
+		{"1.2", tls.VersionTLS12, true},
+		{"TLS1.3", tls.VersionTLS13, true},
+		{"tlsv1.0", tls.VersionTLS10, true},
+		{"1.4", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := parseTLSVersion(tt.in)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("parseTLSVersion(%q) = %#x, %v; want %#x, ok=%v", tt.in, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseCipherSuites(t *testing.T) {
+	ids, err := parseCipherSuites("TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls_ecdhe_ecdsa_with_chacha20_poly1305_sha256")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256}
+	if len(ids) != 2 || ids[0] != want[0] || ids[1] != want[1] {
+		t.Errorf("parseCipherSuites = %v, want %v", ids, want)
+	}
+	for _, bad := range []string{"TLS_NO_SUCH_CIPHER", ","} {
+		if _, err := parseCipherSuites(bad); err == nil {
+			t.Errorf("parseCipherSuites(%q) should fail", bad)
+		}
+	}
+}
+
+// writePEM 把 DER 数据以 PEM 格式写入临时目录并返回文件路径
+func writePEM(t *testing.T, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// serverCAFile 把测试服务器的证书写成 --cacert 使用的文件
+func serverCAFile(t *testing.T, srv *httptest.Server) string {
+	return writePEM(t, "ca.pem", "CERTIFICATE", srv.Certificate().Raw)
+}
+
+// newClientCert 生成自签名的客户端证书，返回证书、私钥文件路径和证书本身
+func newClientCert(t *testing.T) (string, string, *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "tcping test client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writePEM(t, "client.pem", "CERTIFICATE", der), writePEM(t, "client.key", "EC PRIVATE KEY", keyDER), cert
+}
+
+// tlsProbe 按 opts 加载 TLS 配置并执行一次探测，返回结果和输出
+func tlsProbe(t *testing.T, uri string, opts *Options) (probeResult, string) {
+	t.Helper()
+	if err := loadTLSConfig(opts); err != nil {
+		t.Fatalf("loadTLSConfig: %v", err)
+	}
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	client := newHTTPProbeClient(opts)
+	defer client.client.CloseIdleConnections()
+	r := httpPingOnce(context.Background(), client, uri, 5000, &Statistics{}, 0, opts)
+	return r, buf.String()
+}
+
+func TestHTTPPingOnceCACertAndSNI(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+	ca := serverCAFile(t, srv)
+
+	tests := []struct {
+		name   string
+		opts   Options
+		wantOK bool
+		reason string
+	}{
+		{"system roots", Options{}, false, "unknown authority"},
+		{"custom CA", Options{CACert: ca}, true, ""},
+		// httptest 证书对 example.com 有效
+		{"matching SNI", Options{CACert: ca, SNI: "example.com"}, true, ""},
+		{"mismatched SNI", Options{CACert: ca, SNI: "wrong.test"}, false, "not valid for wrong.test"},
+	}
+	for _, tt := range tests {
+		r, _ := tlsProbe(t, srv.URL, &tt.opts)
+		if r.success != tt.wantOK {
+			t.Errorf("%s: success = %v, want %v (err %q)", tt.name, r.success, tt.wantOK, r.err)
+		}
+		if !strings.Contains(r.err, tt.reason) {
+			t.Errorf("%s: error %q does not contain %q", tt.name, r.err, tt.reason)
+		}
+	}
+}
+
+func TestHTTPPingOnceClientCertificate(t *testing.T) {
+	certFile, keyFile, clientCert := newClientCert(t)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	pool := x509.NewCertPool()
+	pool.AddCert(clientCert)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+	ca := serverCAFile(t, srv)
+
+	r, _ := tlsProbe(t, srv.URL, &Options{CACert: ca})
+	if r.success || !strings.Contains(r.err, "certificate required") {
+		t.Errorf("without client certificate: success = %v, err %q; want a certificate required alert", r.success, r.err)
+	}
+	if r, _ := tlsProbe(t, srv.URL, &Options{CACert: ca, Cert: certFile, Key: keyFile}); !r.success {
+		t.Errorf("with client certificate: %s", r.err)
+	}
+}
+
+func TestHTTPPingOnceTLSVersionAndCiphers(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	opts := &Options{
+		InsecureSSL: true,
+		VerboseMode: true,
+		TLSMax:      tls.VersionTLS12,
+		Ciphers:     []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256},
+	}
+	r, out := tlsProbe(t, srv.URL, opts)
+	if !r.success {
+		t.Fatalf("probe failed: %s", r.err)
+	}
+	if !strings.Contains(out, "TLS 1.2, TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256") {
+		t.Errorf("verbose output %q does not show the pinned version and cipher", out)
+	}
+
+	r, _ = tlsProbe(t, srv.URL, &Options{InsecureSSL: true, TLSMax: tls.VersionTLS11})
+	if r.success || !strings.Contains(r.err, "protocol version") {
+		t.Errorf("TLS 1.1 against a TLS 1.2+ server: success = %v, err %q", r.success, r.err)
+	}
+}
+
+func TestHTTPPingOnceNotTLS(t *testing.T) {
+	// 模拟 SSH 等非 TLS 服务，连接后先发送自己的协议标识
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			io.WriteString(conn, "SSH-2.0-OpenSSH_9.6\r\n")
+			conn.Close()
+		}
+	}()
+
+	r, _ := tlsProbe(t, "https://"+ln.Addr().String(), &Options{})
+	if r.success || r.err != describeTLSError(tls.RecordHeaderError{}) {
+		t.Errorf("https to a non-TLS server: err %q", r.err)
+	}
+}
+
+func TestLoadTLSConfigErrors(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	os.WriteFile(empty, []byte("not a certificate"), 0o600)
+	for _, opts := range []Options{
+		{CACert: filepath.Join(t.TempDir(), "missing.pem")},
+		{CACert: empty},
+		{Key: empty},
+		{Cert: empty},
+	} {
+		if err := loadTLSConfig(&opts); err == nil {
+			t.Errorf("loadTLSConfig(%+v) should fail", opts)
+		}
+	}
+}
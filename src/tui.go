@@ -0,0 +1,237 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+const (
+	// 仪表盘保留的最近探测结果数量
+	dashboardHistory = 512
+	// 仪表盘中显示的最近中断次数
+	dashboardOutages = 5
+)
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// outage 表示一段连续失败的时间区间
+type outage struct {
+	start   time.Time
+	end     time.Time // 最后一次失败或恢复后首次成功的时间
+	probes  int       // 区间内失败的探测次数
+	ongoing bool
+}
+
+// updateOutages 根据一次探测结果更新中断区间列表
+func updateOutages(outages []outage, r probeResult) []outage {
+	n := len(outages)
+	ongoing := n > 0 && outages[n-1].ongoing
+	switch {
+	case !r.success && ongoing:
+		outages[n-1].probes++
+		outages[n-1].end = r.time
+	case !r.success:
+		outages = append(outages, outage{start: r.time, end: r.time, probes: 1, ongoing: true})
+	case ongoing:
+		outages[n-1].ongoing = false
+		outages[n-1].end = r.time
+	}
+	return outages
+}
+
+// dashboard 是 --tui 的全屏实时视图，每次探测后重绘
+type dashboard struct {
+	target  string
+	stats   *Statistics
+	out     *os.File
+	started time.Time
+	history []probeResult
+	outages []outage
+	lastErr *probeResult
+	color   bool // -c 是否启用彩色输出
+}
+
+// newDashboard 在标准输出是终端时创建仪表盘，否则返回 nil
+func newDashboard(target string, stats *Statistics, color bool) *dashboard {
+	if os.Getenv("TERM") == "dumb" {
+		return nil
+	}
+	if _, _, ok := terminalSize(os.Stdout); !ok {
+		return nil
+	}
+	return &dashboard{
+		target:  target,
+		stats:   stats,
+		out:     os.Stdout,
+		started: time.Now(),
+		color:   color,
+	}
+}
+
+// start 切换到备用屏幕并隐藏光标
+func (d *dashboard) start() {
+	fmt.Fprint(d.out, "\033[?1049h\033[?25l")
+	d.render()
+}
+
+// stop 恢复光标并返回主屏幕，之后的输出照常滚动显示
+func (d *dashboard) stop() {
+	fmt.Fprint(d.out, "\033[?25h\033[?1049l")
+}
+
+func (d *dashboard) record(r probeResult) {
+	d.history = append(d.history, r)
+	if len(d.history) > dashboardHistory {
+		d.history = d.history[len(d.history)-dashboardHistory:]
+	}
+	d.outages = updateOutages(d.outages, r)
+	if !r.success {
+		last := r
+		d.lastErr = &last
+	}
+	d.render()
+}
+
+func (d *dashboard) render() {
+	lang := i18n.T()
+	width, height, ok := terminalSize(d.out)
+	if !ok {
+		width, height = 80, 24
+	}
+	const labelWidth = 12
+	barWidth := width - labelWidth - 2
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	sent, responded, minTime, maxTime, avgTime := d.stats.getStats()
+
+	var b strings.Builder
+	b.WriteString("\033[H\033[2J")
+	line := func(s string) {
+		b.WriteString(s)
+		b.WriteString("\033[K\r\n")
+	}
+	label := func(s string) string {
+		return infoText(fmt.Sprintf("%-*s", labelWidth, s), d.color)
+	}
+
+	line(fmt.Sprintf(lang.MsgTUITitle(), programName, d.target))
+	line(fmt.Sprintf(lang.MsgTUIElapsed(), time.Since(d.started).Round(time.Second), sent))
+	line(strings.Repeat("─", width))
+
+	rttLine := label(lang.MsgTUIRTT()) + sparkline(d.history, barWidth-16, d.color)
+	if n := len(d.history); n > 0 && d.history[n-1].success {
+		rttLine += " " + fmt.Sprintf(lang.MsgTUILastRTT(), d.history[n-1].rtt)
+	}
+	line(rttLine)
+
+	var lossRate float64
+	if sent > 0 {
+		lossRate = float64(sent-responded) / float64(sent) * 100
+	}
+	line(label(lang.MsgTUILoss()) + lossBar(lossRate, barWidth-24, d.color) + " " +
+		fmt.Sprintf(lang.MsgTUILossDetail(), lossRate, sent-responded, sent))
+
+	if responded > 0 {
+		p := d.stats.getPercentiles(50, 90, 99)
+		line(label(lang.MsgTUIStatsLabel()) + fmt.Sprintf(lang.MsgTUIStats(), minTime, avgTime, maxTime, p[0], p[1], p[2]))
+	} else {
+		line(label(lang.MsgTUIStatsLabel()) + "-")
+	}
+
+	if d.lastErr != nil {
+		line(label(lang.MsgTUILastError()) + errorText(fmt.Sprintf("%s seq=%d %s",
+			d.lastErr.time.Format("15:04:05"), d.lastErr.seq, d.lastErr.err), d.color))
+	} else {
+		line(label(lang.MsgTUILastError()) + lang.MsgTUINone())
+	}
+
+	line("")
+	line(infoText(lang.MsgTUIOutages(), d.color))
+	if len(d.outages) == 0 {
+		line("  " + lang.MsgTUINone())
+	}
+	first := len(d.outages) - dashboardOutages
+	if first < 0 {
+		first = 0
+	}
+	for _, o := range d.outages[first:] {
+		end := o.end.Format("15:04:05")
+		if o.ongoing {
+			end = lang.MsgTUIOutageOngoing()
+		}
+		entry := fmt.Sprintf(lang.MsgTUIOutageEntry(), o.start.Format("15:04:05"), end, o.probes,
+			o.end.Sub(o.start).Round(time.Millisecond))
+		if o.ongoing {
+			entry = errorText(entry, d.color)
+		}
+		line("  " + entry)
+	}
+
+	// 提示信息固定在最后一行
+	used := strings.Count(b.String(), "\r\n")
+	for i := used; i < height-1; i++ {
+		line("")
+	}
+	b.WriteString(lang.MsgTUIHint())
+	b.WriteString("\033[K")
+
+	fmt.Fprint(d.out, b.String())
+}
+
+// sparkline 将最近的探测结果绘制为迷你折线图，失败显示为红色 ✕
+func sparkline(history []probeResult, width int, color bool) string {
+	if width < 1 {
+		width = 1
+	}
+	if len(history) > width {
+		history = history[len(history)-width:]
+	}
+
+	lo, hi := 0.0, 0.0
+	first := true
+	for _, r := range history {
+		if !r.success {
+			continue
+		}
+		if first || r.rtt < lo {
+			lo = r.rtt
+		}
+		if first || r.rtt > hi {
+			hi = r.rtt
+		}
+		first = false
+	}
+
+	var b strings.Builder
+	for _, r := range history {
+		if !r.success {
+			b.WriteString(errorText("✕", color))
+			continue
+		}
+		level := len(sparkBlocks) - 1
+		if hi > lo {
+			level = int((r.rtt - lo) / (hi - lo) * float64(len(sparkBlocks)-1))
+		}
+		b.WriteString(successText(string(sparkBlocks[level]), color))
+	}
+	return b.String()
+}
+
+// lossBar 绘制丢包率进度条
+func lossBar(lossRate float64, width int, color bool) string {
+	if width < 10 {
+		width = 10
+	}
+	filled := int(lossRate / 100 * float64(width))
+	if lossRate > 0 && filled == 0 {
+		filled = 1
+	}
+	return "[" + errorText(strings.Repeat("█", filled), color) +
+		strings.Repeat("░", width-filled) + "]"
+}
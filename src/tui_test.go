@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUpdateOutages(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	results := []bool{true, false, false, true, false, true, true}
+	var outages []outage
+	for i, ok := range results {
+		outages = updateOutages(outages, probeResult{seq: i, time: base.Add(time.Duration(i) * time.Second), success: ok})
+	}
+	if len(outages) != 2 {
+		t.Fatalf("outages = %d, want 2", len(outages))
+	}
+	if outages[0].probes != 2 || outages[0].ongoing {
+		t.Errorf("first outage = %+v, want 2 probes and recovered", outages[0])
+	}
+	if got := outages[0].end.Sub(outages[0].start); got != 2*time.Second {
+		t.Errorf("first outage duration = %v, want 2s", got)
+	}
+	if outages[1].probes != 1 || outages[1].ongoing {
+		t.Errorf("second outage = %+v, want 1 probe and recovered", outages[1])
+	}
+
+	outages = updateOutages(outages, probeResult{time: base.Add(10 * time.Second)})
+	if !outages[2].ongoing {
+		t.Error("trailing failure should leave an ongoing outage")
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	history := []probeResult{
+		{success: true, rtt: 10},
+		{success: false},
+		{success: true, rtt: 20},
+	}
+	line := sparkline(history, 10, true)
+	if !strings.Contains(line, "▁") || !strings.Contains(line, "█") || !strings.Contains(line, "✕") {
+		t.Errorf("sparkline = %q, want lowest, highest and failure markers", line)
+	}
+
+	// 只保留最近 width 个结果
+	if got := strings.Count(sparkline(history, 2, true), "✕"); got != 1 {
+		t.Errorf("truncated sparkline failures = %d, want 1", got)
+	}
+	if strings.Contains(sparkline(history, 1, true), "✕") {
+		t.Error("sparkline with width 1 should only contain the latest result")
+	}
+}
+
+func TestLossBar(t *testing.T) {
+	if bar := lossBar(0, 10, true); strings.Contains(bar, "█") {
+		t.Errorf("lossBar(0) = %q, want empty bar", bar)
+	}
+	if bar := lossBar(0.1, 10, true); strings.Count(bar, "█") != 1 {
+		t.Errorf("lossBar(0.1) = %q, want at least one filled cell", bar)
+	}
+	if bar := lossBar(100, 10, true); strings.Contains(bar, "░") {
+		t.Errorf("lossBar(100) = %q, want full bar", bar)
+	}
+}
+
+func TestDashboardHonorsColor(t *testing.T) {
+	history := []probeResult{{success: true, rtt: 10}, {success: false}}
+	if line := sparkline(history, 10, false); strings.Contains(line, "\033[") {
+		t.Errorf("sparkline without -c = %q, want no escape codes", line)
+	}
+	if bar := lossBar(50, 10, false); strings.Contains(bar, "\033[") {
+		t.Errorf("lossBar without -c = %q, want no escape codes", bar)
+	}
+	if line := sparkline(history, 10, true); !strings.Contains(line, "\033[") {
+		t.Errorf("sparkline with -c = %q, want colored output", line)
+	}
+}
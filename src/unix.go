@@ -0,0 +1,34 @@
+package main
+
+import (
+	"strings"
+)
+
+// TCP 模式下 Unix 域套接字目标的前缀，如 unix:/var/run/docker.sock
+const unixTargetPrefix = "unix:"
+
+// unixTargetPath 返回 unix:/path 形式目标中的套接字路径
+func unixTargetPath(target string) (string, bool) {
+	if !strings.HasPrefix(target, unixTargetPrefix) {
+		return "", false
+	}
+	path := strings.TrimPrefix(target, unixTargetPrefix)
+	return path, path != ""
+}
+
+// unixSocketURI 补全 --unix-socket 模式下只写了路径的 URI。
+// 经 Unix 域套接字请求时 URI 只提供路径和 Host 头，主机名默认为 localhost
+func unixSocketURI(uri string) string {
+	if strings.HasPrefix(uri, "/") {
+		return "http://localhost" + uri
+	}
+	return uri
+}
+
+// firstArg 返回第一个位置参数，没有时返回空字符串
+func firstArg(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
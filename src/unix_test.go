@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUnixSocketURI(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"/_ping", "http://localhost/_ping"},
+		{"http://docker/v1.43/info", "http://docker/v1.43/info"},
+	}
+	for _, tt := range tests {
+		if got := unixSocketURI(tt.in); got != tt.want {
+			t.Errorf("unixSocketURI(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUnixTargetPath(t *testing.T) {
+	if path, ok := unixTargetPath("unix:/run/app.sock"); !ok || path != "/run/app.sock" {
+		t.Errorf("unixTargetPath = %q, %v", path, ok)
+	}
+	for _, s := range []string{"unix:", "example.com", ""} {
+		if _, ok := unixTargetPath(s); ok {
+			t.Errorf("unixTargetPath(%q) should not match", s)
+		}
+	}
+}
+
+// listenUnix 在临时目录中创建 Unix 域套接字监听
+func listenUnix(t *testing.T) (net.Listener, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.sock")
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Skipf("unix sockets not available: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	return ln, path
+}
+
+func TestHTTPPingOnceUnixSocket(t *testing.T) {
+	ln, path := listenUnix(t)
+	var gotHost, gotPath string
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHost, gotPath = r.Host, r.URL.Path
+		io.WriteString(w, "OK")
+	})}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	opts := &Options{UnixSocket: path}
+	out := probeOutput(t, unixSocketURI("http://docker/_ping"), opts)
+	if !strings.Contains(out, "HTTP 200 ") {
+		t.Errorf("output %q does not report success", out)
+	}
+	if gotHost != "docker" || gotPath != "/_ping" {
+		t.Errorf("request Host = %q, path = %q; want docker and /_ping", gotHost, gotPath)
+	}
+}
+
+func TestPingOnceUnixTarget(t *testing.T) {
+	ln, path := listenUnix(t)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	output = io.Discard
+	defer func() { output = os.Stdout }()
+	target := unixTargetPrefix + path
+	stats := &Statistics{}
+	if r := pingOnce(context.Background(), target, "", 2000, stats, 0, target, &Options{}); !r.success {
+		t.Fatalf("probe failed: %s", r.err)
+	}
+	missing := unixTargetPrefix + filepath.Join(t.TempDir(), "missing.sock")
+	if r := pingOnce(context.Background(), missing, "", 2000, stats, 1, missing, &Options{}); r.success {
+		t.Error("probe of a missing socket should fail")
+	}
+}
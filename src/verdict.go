@@ -0,0 +1,124 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// 进程退出码，脚本可以据此判断探测结果
+const (
+	exitOK              = 0 // 全部成功，或设置了阈值且全部通过
+	exitPartialLoss     = 1 // 部分探测失败
+	exitTotalLoss       = 2 // 全部探测失败
+	exitResolveError    = 3 // 目标地址解析失败
+	exitUsage           = 4 // 参数错误
+	exitThresholdFailed = 5 // 未通过 --max-loss/--max-avg-rtt/--max-p99-rtt 阈值
+	exitRuntimeError    = 6 // 运行时错误：文件无法读写、没有创建套接字的权限等
+)
+
+// exitCodeFor 区分参数错误和运行时错误：参数本身有效，但读取文件或
+// 因权限不足失败时属于运行时错误
+func exitCodeFor(err error) int {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) || errors.Is(err, fs.ErrPermission) {
+		return exitRuntimeError
+	}
+	return exitUsage
+}
+
+// parseLossPercent 解析丢包率阈值，接受 5% 或 5 两种写法
+func parseLossPercent(s string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "%"), 64)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 || v > 100 {
+		return 0, errors.New("loss percentage out of range")
+	}
+	return v, nil
+}
+
+// hasThresholds 判断是否设置了任一通过/失败阈值
+func hasThresholds(opts *Options) bool {
+	return opts.MaxLoss >= 0 || opts.MaxAvgRTT > 0 || opts.MaxP99RTT > 0
+}
+
+// verdict 是运行结束时对统计结果的判定
+type verdict struct {
+	checked  bool     // 是否设置了阈值
+	pass     bool     // 是否判定为通过
+	failures []string // 未通过的阈值说明
+	exitCode int
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// evaluateVerdict 根据统计结果、阈值和停止原因计算判定和退出码
+func evaluateVerdict(stats *Statistics, opts *Options, reason stopReason) verdict {
+	lang := i18n.T()
+	sent, responded, _, _, avg := stats.getStats()
+	v := verdict{checked: hasThresholds(opts), pass: true, exitCode: exitOK}
+
+	var lossRate float64
+	if sent > 0 {
+		lossRate = float64(sent-responded) / float64(sent) * 100
+	}
+
+	if opts.MaxLoss >= 0 && lossRate > opts.MaxLoss {
+		v.failures = append(v.failures, fmt.Sprintf(lang.MsgThresholdLoss(), lossRate, opts.MaxLoss))
+	}
+	if opts.MaxAvgRTT > 0 || opts.MaxP99RTT > 0 {
+		if responded == 0 {
+			v.failures = append(v.failures, lang.MsgThresholdNoResponse())
+		} else {
+			if limit := durationMillis(opts.MaxAvgRTT); opts.MaxAvgRTT > 0 && avg > limit {
+				v.failures = append(v.failures, fmt.Sprintf(lang.MsgThresholdAvgRTT(), avg, limit))
+			}
+			if limit := durationMillis(opts.MaxP99RTT); opts.MaxP99RTT > 0 {
+				if p99 := stats.getPercentile(99); p99 > limit {
+					v.failures = append(v.failures, fmt.Sprintf(lang.MsgThresholdP99RTT(), p99, limit))
+				}
+			}
+		}
+	}
+
+	switch {
+	case sent > 0 && responded == 0:
+		v.pass = false
+		v.exitCode = exitTotalLoss
+	case len(v.failures) > 0:
+		v.pass = false
+		v.exitCode = exitThresholdFailed
+	case reason == stopUntilSuccess || v.checked:
+		// 等待端口开放成功，或损失在阈值允许范围内
+	case responded < sent:
+		v.pass = false
+		v.exitCode = exitPartialLoss
+	}
+	return v
+}
+
+// printVerdict 在统计信息之后输出阈值判定结果，未设置阈值时不输出
+func printVerdict(v verdict, opts *Options) {
+	if !v.checked {
+		return
+	}
+	lang := i18n.T()
+	if v.pass {
+		fmt.Print(successText(lang.MsgVerdictPass(), opts.ColorOutput))
+		return
+	}
+	reasons := strings.Join(v.failures, "; ")
+	if reasons == "" {
+		reasons = lang.MsgThresholdNoResponse()
+	}
+	fmt.Print(errorText(fmt.Sprintf(lang.MsgVerdictFail(), reasons), opts.ColorOutput))
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func statsWith(rtts []float64, failures int) *Statistics {
+	s := &Statistics{}
+	for _, v := range rtts {
+		s.update(v, true)
+	}
+	for i := 0; i < failures; i++ {
+		s.update(0, false)
+	}
+	return s
+}
+
+func TestParseLossPercent(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{"5%", 5, false},
+		{"2.5", 2.5, false},
+		{"0%", 0, false},
+		{"101%", 0, true},
+		{"-1", 0, true},
+		{"abc", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseLossPercent(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseLossPercent(%q) = %v, %v; want %v, err %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestEvaluateVerdict(t *testing.T) {
+	noThresholds := Options{MaxLoss: -1}
+	tests := []struct {
+		name   string
+		stats  *Statistics
+		opts   Options
+		reason stopReason
+		code   int
+		pass   bool
+	}{
+		{"all ok", statsWith([]float64{10, 20}, 0), noThresholds, stopNone, exitOK, true},
+		{"partial loss", statsWith([]float64{10}, 1), noThresholds, stopNone, exitPartialLoss, false},
+		{"total loss", statsWith(nil, 3), noThresholds, stopNone, exitTotalLoss, false},
+		{"until success after failures", statsWith([]float64{10}, 2), Options{MaxLoss: -1, UntilSuccess: true},
+			stopUntilSuccess, exitOK, true},
+		{"loss within threshold", statsWith(make([]float64, 19), 1), Options{MaxLoss: 5}, stopNone, exitOK, true},
+		{"loss over threshold", statsWith([]float64{10}, 1), Options{MaxLoss: 5}, stopNone, exitThresholdFailed, false},
+		{"avg rtt over threshold", statsWith([]float64{40, 80}, 0), Options{MaxLoss: -1, MaxAvgRTT: 50 * time.Millisecond},
+			stopNone, exitThresholdFailed, false},
+		{"p99 rtt within threshold", statsWith([]float64{10, 20}, 0), Options{MaxLoss: -1, MaxP99RTT: 25 * time.Millisecond},
+			stopNone, exitOK, true},
+		{"p99 rtt over threshold", statsWith([]float64{10, 30}, 0), Options{MaxLoss: -1, MaxP99RTT: 25 * time.Millisecond},
+			stopNone, exitThresholdFailed, false},
+		{"total loss with thresholds", statsWith(nil, 2), Options{MaxLoss: 100}, stopNone, exitTotalLoss, false},
+	}
+	for _, tt := range tests {
+		v := evaluateVerdict(tt.stats, &tt.opts, tt.reason)
+		if v.exitCode != tt.code || v.pass != tt.pass {
+			t.Errorf("%s: exit=%d pass=%v, want exit=%d pass=%v (failures %v)",
+				tt.name, v.exitCode, v.pass, tt.code, tt.pass, v.failures)
+		}
+	}
+}
+
+func TestExitCodeFor(t *testing.T) {
+	// 证书文件不存在是运行时错误，内容无效仍是参数错误
+	missing := &Options{CACert: filepath.Join(t.TempDir(), "missing.pem")}
+	if err := loadTLSConfig(missing); exitCodeFor(err) != exitRuntimeError {
+		t.Errorf("missing CA file: exit code %d, want %d (%v)", exitCodeFor(err), exitRuntimeError, err)
+	}
+	if code := exitCodeFor(errors.New("invalid value")); code != exitUsage {
+		t.Errorf("usage error: exit code %d, want %d", code, exitUsage)
+	}
+	if code := exitCodeFor(syscall.EPERM); code != exitRuntimeError {
+		t.Errorf("EPERM: exit code %d, want %d", code, exitRuntimeError)
+	}
+}
+
+func TestBuildJSONSummary(t *testing.T) {
+	stats := statsWith([]float64{10, 30}, 1)
+	opts := Options{MaxLoss: 50}
+	v := evaluateVerdict(stats, &opts, stopMaxFailures)
+	summary := buildJSONSummary("tcp", "example.com:443", stats, stopMaxFailures, v)
+	if summary.Sent != 3 || summary.Received != 2 || summary.Lost != 1 {
+		t.Errorf("counts = %d/%d/%d, want 3/2/1", summary.Sent, summary.Received, summary.Lost)
+	}
+	if summary.RTT == nil || summary.RTT.Max != 30 || summary.RTT.P50 != 10 {
+		t.Errorf("rtt = %+v", summary.RTT)
+	}
+	if summary.StopReason != "max-failures" {
+		t.Errorf("stop reason = %q", summary.StopReason)
+	}
+	if !summary.Verdict.Checked || !summary.Verdict.Pass || summary.ExitCode != exitOK {
+		t.Errorf("verdict = %+v exit = %d", summary.Verdict, summary.ExitCode)
+	}
+	if summary.HTTP != nil {
+		t.Error("tcp summary should not include HTTP data")
+	}
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"time"
+
+	"tcping/src/i18n"
+)
+
+// WebSocket 帧操作码 (RFC 6455)
+const (
+	wsOpClose = 0x8
+	wsOpPing  = 0x9
+	wsOpPong  = 0xA
+)
+
+// 计算 Sec-WebSocket-Accept 使用的固定 GUID
+const wsAcceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// wsConn 是升级完成后的 WebSocket 连接，只实现探测需要的控制帧
+type wsConn struct {
+	rwc io.ReadWriteCloser
+	br  *bufio.Reader
+}
+
+// wsAccept 计算服务端应返回的 Sec-WebSocket-Accept
+func wsAccept(key string) string {
+	sum := sha1.Sum([]byte(key + wsAcceptGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// wsHTTPURI 把 ws:// 和 wss:// 转换为握手请求使用的 http:// 和 https://
+func wsHTTPURI(uri string) string {
+	switch {
+	case strings.HasPrefix(uri, "ws://"):
+		return "http://" + strings.TrimPrefix(uri, "ws://")
+	case strings.HasPrefix(uri, "wss://"):
+		return "https://" + strings.TrimPrefix(uri, "wss://")
+	}
+	return uri
+}
+
+// dialWebSocket 发送 Upgrade 请求完成 WebSocket 握手
+func dialWebSocket(ctx context.Context, client *http.Client, uri string) (*wsConn, error) {
+	lang := i18n.T()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wsHTTPURI(uri), nil)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, 16)
+	rand.Read(nonce)
+	key := base64.StdEncoding.EncodeToString(nonce)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("User-Agent", "tcping/"+version+"."+gitHash)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		resp.Body.Close()
+		return nil, fmt.Errorf(lang.ErrorWSHandshakeStatus(), resp.Status)
+	}
+	rwc, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok || !strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") ||
+		resp.Header.Get("Sec-WebSocket-Accept") != wsAccept(key) {
+		resp.Body.Close()
+		return nil, errors.New(lang.ErrorWSHandshakeInvalid())
+	}
+	return &wsConn{rwc: rwc, br: bufio.NewReader(rwc)}, nil
+}
+
+// writeControl 发送一个控制帧，客户端发送的帧必须使用掩码
+func (c *wsConn) writeControl(opcode byte, payload []byte) error {
+	frame := make([]byte, 0, 6+len(payload))
+	frame = append(frame, 0x80|opcode, 0x80|byte(len(payload)))
+	var mask [4]byte
+	rand.Read(mask[:])
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	_, err := c.rwc.Write(frame)
+	return err
+}
+
+// readFrame 读取一帧，返回操作码和负载。数据帧的负载被丢弃，只返回控制帧的负载
+func (c *wsConn) readFrame() (byte, []byte, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(c.br, head[:]); err != nil {
+		return 0, nil, err
+	}
+	opcode := head[0] & 0x0f
+	masked := head[1]&0x80 != 0
+	length := uint64(head[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+			return 0, nil, err
+		}
+	}
+	if opcode < wsOpClose {
+		// 文本、二进制和后续帧与探测无关
+		_, err := io.CopyN(io.Discard, c.br, int64(length))
+		return opcode, nil, err
+	}
+	if length > 125 {
+		return 0, nil, errors.New(i18n.T().ErrorWSProtocol())
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		return 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return opcode, payload, nil
+}
+
+// ping 发送携带 payload 的 ping 帧并等待对应的 pong。
+// 期间收到的 ping 会回复 pong，收到 close 帧时返回错误
+func (c *wsConn) ping(payload []byte) error {
+	if err := c.writeControl(wsOpPing, payload); err != nil {
+		return err
+	}
+	for {
+		opcode, data, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+		switch opcode {
+		case wsOpPong:
+			// 忽略之前超时的 ping 迟到的 pong
+			if string(data) == string(payload) {
+				return nil
+			}
+		case wsOpPing:
+			if err := c.writeControl(wsOpPong, data); err != nil {
+				return err
+			}
+		case wsOpClose:
+			code, reason := 1005, ""
+			if len(data) >= 2 {
+				code = int(binary.BigEndian.Uint16(data))
+				reason = string(data[2:])
+			}
+			return fmt.Errorf(i18n.T().ErrorWSClosed(), code, reason)
+		}
+	}
+}
+
+func (c *wsConn) close() {
+	c.writeControl(wsOpClose, []byte{0x03, 0xe8}) // 1000 正常关闭
+	c.rwc.Close()
+}
+
+// wsProber 在一个持久连接上执行 ping/pong 探测，连接断开后在下一次探测时重连
+type wsProber struct {
+	uri       string
+	opts      *Options
+	client    *httpProbeClient
+	conn      *wsConn
+	connected bool // 是否曾经建立过连接，用于区分首次连接和重连
+}
+
+func newWSProber(uri string, opts *Options) *wsProber {
+	return &wsProber{uri: uri, opts: opts, client: newHTTPProbeClient(opts)}
+}
+
+// probe 执行一次 ping/pong 测量，需要时先完成握手。
+// 探测耗时为 ping 的往返时间，握手耗时单独统计
+func (p *wsProber) probe(ctx context.Context, seq int, stats *Statistics) probeResult {
+	lang := i18n.T()
+	opts := p.opts
+	result := probeResult{seq: seq, time: time.Now()}
+	timeout := probeTimeout(ctx, opts)
+	fail := func(elapsed float64, err error) probeResult {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			fmt.Fprint(output, infoText(lang.MsgOperationCanceled(), opts.ColorOutput))
+			result.canceled = true
+			return result
+		}
+		stats.update(elapsed, false)
+		fmt.Fprint(output, errorText(fmt.Sprintf(lang.MsgWSFailed(), p.uri, seq, err), opts.ColorOutput))
+		result.rtt = elapsed
+		result.err = err.Error()
+		return result
+	}
+
+	var handshake float64
+	handshook, reconnect := false, false
+	trace := &connTrace{}
+	if p.conn == nil {
+		hsCtx, cancel := context.WithTimeout(ctx, timeout)
+		hsCtx = withProxyTrace(httptrace.WithClientTrace(hsCtx, trace.clientTrace()), trace)
+		start := time.Now()
+		conn, err := dialWebSocket(hsCtx, p.client.client, p.uri)
+		handshake = durationMillis(time.Since(start))
+		cancel()
+		if err != nil {
+			if reason := describeTLSError(err); reason != "" {
+				err = errors.New(reason)
+			}
+			return fail(handshake, err)
+		}
+		handshook, reconnect = true, p.connected
+		p.conn, p.connected = conn, true
+		stats.updateWSHandshake(handshake, reconnect)
+		if trace.proxied {
+			stats.updateProxy(trace.proxy)
+		}
+	}
+
+	// 超时或被中断时关闭连接，使阻塞的读取返回
+	conn := p.conn
+	timer := time.AfterFunc(timeout, func() { conn.rwc.Close() })
+	stop := context.AfterFunc(ctx, func() { conn.rwc.Close() })
+	payload := binary.BigEndian.AppendUint64(nil, uint64(time.Now().UnixNano()))
+	start := time.Now()
+	err := conn.ping(payload)
+	elapsed := durationMillis(time.Since(start))
+	timedOut := !timer.Stop()
+	stop()
+	if err != nil {
+		// 连接已不可用，下一次探测重新握手
+		conn.rwc.Close()
+		p.conn = nil
+		if timedOut {
+			err = fmt.Errorf(lang.ErrorWSPongTimeout(), timeout.Milliseconds())
+		}
+		return fail(elapsed, err)
+	}
+
+	stats.update(elapsed, true)
+	result.rtt = elapsed
+	result.success = true
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "WebSocket %s: seq=%d time=%.2fms", p.uri, seq, elapsed)
+	if handshook {
+		fmt.Fprintf(&msg, " handshake=%.2fms", handshake)
+		if reconnect {
+			msg.WriteString(" (reconnect)")
+		}
+	}
+	msg.WriteByte('\n')
+	fmt.Fprint(output, successText(msg.String(), opts.ColorOutput))
+	if opts.VerboseMode && handshook {
+		fmt.Fprintf(output, lang.MsgVerboseWSHandshake(), handshake, trace.connectMillis(), trace.tlsMillis())
+		if trace.proxied {
+			fmt.Fprintf(output, lang.MsgVerboseHTTPProxy(), opts.proxy,
+				durationMillis(trace.proxy.connect), durationMillis(trace.proxy.tunnel))
+		}
+	}
+	return result
+}
+
+// close 发送 close 帧并关闭当前连接
+func (p *wsProber) close() {
+	if p.conn != nil {
+		p.conn.close()
+		p.conn = nil
+	}
+}
+
+// updateWSHandshake 记录一次 WebSocket 握手的耗时，reconnect 表示断线后重连
+func (s *Statistics) updateWSHandshake(elapsed float64, reconnect bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.wsHandshakes.add(elapsed)
+	if reconnect {
+		s.wsReconnects++
+	}
+}
+
+// getWSStats 返回 WebSocket 握手耗时统计和重连次数
+func (s *Statistics) getWSStats() (latencyGroup, int64) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.wsHandshakes, s.wsReconnects
+}
+
+// printWSStatistics 输出 ping/pong 往返时间、握手耗时和重连次数
+func printWSStatistics(stats *Statistics) {
+	lang := i18n.T()
+	sent, responded, minTime, maxTime, avgTime := stats.getStats()
+	fmt.Print(lang.MsgWSStatisticsTitle())
+	if sent == 0 {
+		return
+	}
+	lossRate := float64(sent-responded) / float64(sent) * 100
+	fmt.Printf(lang.MsgStatisticsSummary(), sent, responded, sent-responded, lossRate)
+	if responded > 0 {
+		fmt.Printf(lang.MsgStatisticsRTT(), minTime, maxTime, avgTime)
+	}
+	handshakes, reconnects := stats.getWSStats()
+	if handshakes.count > 0 {
+		fmt.Printf(lang.MsgStatisticsWSHandshake(), handshakes.count, handshakes.min, handshakes.max, handshakes.avg())
+	}
+	fmt.Printf(lang.MsgStatisticsWSReconnects(), reconnects)
+	printProxyStatistics(stats)
+}
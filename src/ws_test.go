@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWSAccept(t *testing.T) {
+	// RFC 6455 第 1.3 节的示例
+	if got := wsAccept("dGhlIHNhbXBsZSBub25jZQ=="); got != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Errorf("wsAccept = %q", got)
+	}
+}
+
+func TestWSHTTPURI(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"ws://example.com/chat", "http://example.com/chat"},
+		{"wss://example.com:8443/", "https://example.com:8443/"},
+	}
+	for _, tt := range tests {
+		if got := wsHTTPURI(tt.in); got != tt.want {
+			t.Errorf("wsHTTPURI(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// wsTestServer 完成 WebSocket 握手并对 ping 回复 pong。
+// 每个连接在回复 pongs 个 pong 后关闭（0 表示不限），silent 时不回复 pong
+type wsTestServer struct {
+	*httptest.Server
+	pongs   int
+	silent  bool
+	handled atomic.Int64 // 完成握手的连接数
+}
+
+func startWSTestServer(t *testing.T, pongs int, silent bool) *wsTestServer {
+	t.Helper()
+	s := &wsTestServer{pongs: pongs, silent: silent}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *wsTestServer) uri() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http") + "/ws"
+}
+
+func (s *wsTestServer) serve(w http.ResponseWriter, r *http.Request) {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		http.Error(w, "upgrade required", http.StatusUpgradeRequired)
+		return
+	}
+	conn, rw, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	s.handled.Add(1)
+	io.WriteString(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n"+
+		"Sec-WebSocket-Accept: "+wsAccept(r.Header.Get("Sec-WebSocket-Key"))+"\r\n\r\n")
+	rw.Flush()
+
+	for n := 0; s.pongs == 0 || n < s.pongs; n++ {
+		opcode, payload, err := readMaskedFrame(rw.Reader)
+		if err != nil || opcode == wsOpClose {
+			return
+		}
+		if opcode != wsOpPing || s.silent {
+			continue
+		}
+		// 服务端发送的帧不加掩码
+		frame := append([]byte{0x80 | wsOpPong, byte(len(payload))}, payload...)
+		conn.Write(frame)
+	}
+	conn.Write([]byte{0x80 | wsOpClose, 2, 0x03, 0xe9}) // 1001 going away
+}
+
+// readMaskedFrame 读取客户端发送的一个短帧
+func readMaskedFrame(br *bufio.Reader) (byte, []byte, error) {
+	var head [6]byte
+	if _, err := io.ReadFull(br, head[:]); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, head[1]&0x7f)
+	if _, err := io.ReadFull(br, payload); err != nil {
+		return 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= head[2+i%4]
+	}
+	return head[0] & 0x0f, payload, nil
+}
+
+// wsProbes 对 uri 执行 n 次探测，返回每次的结果、统计和输出
+func wsProbes(t *testing.T, uri string, n int, opts *Options) ([]probeResult, *Statistics, string) {
+	t.Helper()
+	var buf strings.Builder
+	output = &buf
+	defer func() { output = os.Stdout }()
+	prober := newWSProber(uri, opts)
+	defer prober.close()
+	stats := &Statistics{}
+	var results []probeResult
+	for seq := 0; seq < n; seq++ {
+		results = append(results, prober.probe(context.Background(), seq, stats))
+	}
+	return results, stats, buf.String()
+}
+
+func TestWSProbePersistentConnection(t *testing.T) {
+	srv := startWSTestServer(t, 0, false)
+	results, stats, out := wsProbes(t, srv.uri(), 3, &Options{Timeout: 2000, VerboseMode: true})
+	for _, r := range results {
+		if !r.success {
+			t.Fatalf("seq %d failed: %s", r.seq, r.err)
+		}
+	}
+	if got := strings.Count(out, "handshake="); got != 1 {
+		t.Errorf("handshake reported %d times, want once:\n%s", got, out)
+	}
+	if !strings.Contains(out, "Handshake: total = ") {
+		t.Errorf("verbose output %q lacks handshake breakdown", out)
+	}
+	if srv.handled.Load() != 1 {
+		t.Errorf("server saw %d connections, want 1", srv.handled.Load())
+	}
+	handshakes, reconnects := stats.getWSStats()
+	if handshakes.count != 1 || reconnects != 0 {
+		t.Errorf("handshakes = %d, reconnects = %d; want 1, 0", handshakes.count, reconnects)
+	}
+}
+
+func TestWSProbeReconnect(t *testing.T) {
+	// 服务端每个连接只回复一次 pong，随后发送 close 帧
+	srv := startWSTestServer(t, 1, false)
+	results, stats, out := wsProbes(t, srv.uri(), 3, &Options{Timeout: 2000})
+	if !results[0].success || results[1].success || !results[2].success {
+		t.Fatalf("results = %+v, want success, failure, success", results)
+	}
+	if !strings.Contains(results[1].err, "1001") {
+		t.Errorf("close error %q does not include the close code", results[1].err)
+	}
+	if !strings.Contains(out, "(reconnect)") {
+		t.Errorf("output %q does not mark the reconnect", out)
+	}
+	if handshakes, reconnects := stats.getWSStats(); handshakes.count != 2 || reconnects != 1 {
+		t.Errorf("handshakes = %d, reconnects = %d; want 2, 1", handshakes.count, reconnects)
+	}
+}
+
+func TestWSProbePongTimeout(t *testing.T) {
+	srv := startWSTestServer(t, 0, true)
+	results, _, _ := wsProbes(t, srv.uri(), 1, &Options{Timeout: 200})
+	if results[0].success || !strings.Contains(results[0].err, "pong") {
+		t.Errorf("result = %+v, want pong timeout", results[0])
+	}
+}
+
+func TestWSProbeHandshakeRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+	uri := "ws" + strings.TrimPrefix(srv.URL, "http")
+	results, stats, _ := wsProbes(t, uri, 1, &Options{Timeout: 2000})
+	if results[0].success || !strings.Contains(results[0].err, "404") {
+		t.Errorf("result = %+v, want handshake rejected with 404", results[0])
+	}
+	if handshakes, _ := stats.getWSStats(); handshakes.count != 0 {
+		t.Error("failed handshakes should not be counted")
+	}
+}
+
+func TestWSProbeUnixSocket(t *testing.T) {
+	ln, path := listenUnix(t)
+	srv := &wsTestServer{}
+	go (&http.Server{Handler: http.HandlerFunc(srv.serve)}).Serve(ln)
+	results, _, _ := wsProbes(t, "ws://localhost/ws", 1, &Options{Timeout: 2000, UnixSocket: path})
+	if !results[0].success {
+		t.Errorf("probe over unix socket failed: %s", results[0].err)
+	}
+}
+
+func TestValidateWebSocketOptions(t *testing.T) {
+	if err := validateModes(&Options{WebSocket: true, InsecureSSL: true, Proxy: "socks5://p"}); err != nil {
+		t.Errorf("valid --ws options rejected: %v", err)
+	}
+	for _, o := range []Options{
+		{WebSocket: true, HTTPMode: true},
+		{WebSocket: true, HTTP2: true},
+		{WebSocket: true, Follow: true},
+	} {
+		if err := validateModes(&o); err == nil || !strings.Contains(err.Error(), "--ws") {
+			t.Errorf("validateModes(%+v) = %v, want conflict with --ws", o, err)
+		}
+	}
+}